@@ -0,0 +1,210 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// BigVersion is a semantic version whose major, minor and patch numbers are
+// kept as decimal digits, so that they can be larger than an int. The
+// specification puts no upper bound on the numbers; Version, whose numbers
+// are ints, rejects "99999999999999999999.0.0", but BigVersion accepts it.
+//
+// Like Version, BigVersion is a plain value: its fields are its whole state,
+// so == and map keys work as expected for versions built by ParseBig. An
+// empty number is treated as 0, so the zero BigVersion is 0.0.0.
+//
+// Use Version for everything else; Version.Big and BigVersion.Version
+// convert between the two.
+type BigVersion struct {
+	Major      string // Major version number, as decimal digits
+	Minor      string // Minor version number, as decimal digits
+	Patch      string // Patch version number, as decimal digits
+	PreRelease string // Pre-release identifier (alpha, beta, rc.1, etc.)
+	Build      string // Build metadata (+20130313144700, +exp.sha.5114f85, etc.)
+}
+
+// ParseBig parses s as a semantic version with the same grammar as Parse,
+// but without a limit on the size of the major, minor and patch numbers.
+//
+// On failure the returned error is a *ParseError.
+//
+// Examples:
+//   - ParseBig("1.0.0") returns BigVersion{"1", "0", "0", "", ""}
+//   - ParseBig("99999999999999999999.0.0-rc.1") returns BigVersion{"99999999999999999999", "0", "0", "rc.1", ""}
+func ParseBig(s string) (BigVersion, error) {
+	var b BigVersion
+	var err error
+	pos := 0
+
+	if b.Major, pos, err = parseDigits(s, pos, ComponentMajor); err != nil {
+		return BigVersion{}, err
+	} else if pos, err = expectDot(s, pos, ComponentMajor); err != nil {
+		return BigVersion{}, err
+	}
+	if b.Minor, pos, err = parseDigits(s, pos, ComponentMinor); err != nil {
+		return BigVersion{}, err
+	} else if pos, err = expectDot(s, pos, ComponentMinor); err != nil {
+		return BigVersion{}, err
+	}
+	if b.Patch, pos, err = parseDigits(s, pos, ComponentPatch); err != nil {
+		return BigVersion{}, err
+	}
+
+	var tail Version
+	if err = parseTail(s, pos, &tail, ComponentPatch); err != nil {
+		return BigVersion{}, err
+	}
+	b.PreRelease, b.Build = tail.PreRelease, tail.Build
+
+	return b, nil
+}
+
+// MustParseBig is like ParseBig but panics if s is not a valid semantic version.
+func MustParseBig(s string) BigVersion {
+	b, err := ParseBig(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Big returns v as a BigVersion.
+func (v Version) Big() BigVersion {
+	return BigVersion{
+		Major:      strconv.Itoa(v.Major),
+		Minor:      strconv.Itoa(v.Minor),
+		Patch:      strconv.Itoa(v.Patch),
+		PreRelease: v.PreRelease,
+		Build:      v.Build,
+	}
+}
+
+// Version returns b as a Version. It returns an error if a number is not
+// decimal digits or is too large for an int.
+func (b BigVersion) Version() (Version, error) {
+	v := Version{PreRelease: b.PreRelease, Build: b.Build}
+	for _, n := range []struct {
+		c     Component
+		value string
+		field *int
+	}{{ComponentMajor, b.Major, &v.Major}, {ComponentMinor, b.Minor, &v.Minor}, {ComponentPatch, b.Patch, &v.Patch}} {
+		digits := bigNumber(n.value)
+		if !isNumeric(digits) {
+			return Version{}, fmt.Errorf("semver: invalid %s number %q", n.c, n.value)
+		}
+		i, err := strconv.Atoi(digits)
+		if err != nil {
+			return Version{}, fmt.Errorf("semver: %s number %s is too large for a Version", n.c, digits)
+		}
+		*n.field = i
+	}
+	return v, nil
+}
+
+// bigNumber returns the digits of a BigVersion number, which are "0" when empty.
+func bigNumber(digits string) string {
+	if digits == "" {
+		return "0"
+	}
+	return digits
+}
+
+// core returns the major.minor.patch numbers as a string.
+func (b BigVersion) core() string {
+	return bigNumber(b.Major) + "." + bigNumber(b.Minor) + "." + bigNumber(b.Patch)
+}
+
+// String implements the fmt.Stringer interface and returns the semantic
+// version string, in the same format as Version.String.
+func (b BigVersion) String() string {
+	s := b.core()
+	if b.PreRelease != "" {
+		s += "-" + b.PreRelease
+	}
+	if b.Build != "" {
+		s += "+" + b.Build
+	}
+	return s
+}
+
+// Short returns the semantic version string without build metadata.
+func (b BigVersion) Short() string {
+	if b.PreRelease != "" {
+		return b.core() + "-" + b.PreRelease
+	}
+	return b.core()
+}
+
+// Compare returns -1, 0 or 1 as b has lower, the same or higher precedence
+// than b2, with the rules of Version.Compare. The numbers are compared as
+// digits, by length and then digit by digit, so Compare does not allocate.
+func (b BigVersion) Compare(b2 BigVersion) int {
+	for _, n := range [3][2]string{{b.Major, b2.Major}, {b.Minor, b2.Minor}, {b.Patch, b2.Patch}} {
+		if cmp := compareDigits(n[0], n[1]); cmp != 0 {
+			return cmp
+		}
+	}
+	if b.PreRelease == "" && b2.PreRelease != "" {
+		return 1
+	} else if b.PreRelease != "" && b2.PreRelease == "" {
+		return -1
+	} else if b.PreRelease == "" {
+		return 0
+	}
+	return comparePreRelease(b.PreRelease, b2.PreRelease)
+}
+
+// Less returns true if b has lower precedence than b2.
+// This is a convenience method that calls Compare(b2) < 0.
+func (b BigVersion) Less(b2 BigVersion) bool {
+	return b.Compare(b2) < 0
+}
+
+// Validate checks the version against https://semver.org/ and returns a
+// *ValidationError listing every field that violates it, or nil if the
+// version is valid. It checks the same rules as Version.Validate, and that
+// each number is empty or decimal digits without leading zeros.
+func (b BigVersion) Validate() error {
+	var errs []error
+	for _, n := range []struct {
+		c     Component
+		value string
+	}{{ComponentMajor, b.Major}, {ComponentMinor, b.Minor}, {ComponentPatch, b.Patch}} {
+		if n.value == "" {
+			continue
+		} else if !isNumeric(n.value) {
+			errs = append(errs, &FieldError{Component: n.c, Value: n.value, Offset: -1, Msg: "not a non-negative integer"})
+		} else if n.value[0] == '0' && len(n.value) > 1 {
+			errs = append(errs, &FieldError{Component: n.c, Value: n.value, Offset: 0, Msg: "leading zero"})
+		}
+	}
+	errs = validateTail(errs, b.PreRelease, b.Build)
+	if errs != nil {
+		return &ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The version is encoded with String(). An invalid version is
+// rejected with the error from Validate.
+func (b BigVersion) MarshalText() ([]byte, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The text is parsed with ParseBig.
+func (b *BigVersion) UnmarshalText(text []byte) error {
+	parsed, err := ParseBig(string(text))
+	if err != nil {
+		return err
+	}
+	*b = parsed
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// bigVersions are versions with numbers too large for an int, in increasing order.
+var bigVersions = []string{
+	"1.2.3",
+	"9223372036854775807.0.0",
+	"9223372036854775808.0.0-rc.1",
+	"9223372036854775808.0.0",
+	"9223372036854775808.1.99999999999999999999",
+	"9223372036854775808.2.0",
+	"99999999999999999999.0.0-alpha",
+	"99999999999999999999.0.0",
+	"99999999999999999999.99999999999999999999.99999999999999999999",
+	"100000000000000000000.0.0",
+}
+
+// Test that ParseBig, String and Compare handle numbers too large for an int
+func TestBigVersion(t *testing.T) {
+	for i, si := range bigVersions {
+		bi, err := semver.ParseBig(si)
+		if err != nil {
+			t.Fatalf("ParseBig(%q): unexpected error: %v", si, err)
+		}
+		if bi.String() != si {
+			t.Errorf("String: expected %q, actual %q", si, bi)
+		}
+		if err := bi.Validate(); err != nil {
+			t.Errorf("Validate(%s): unexpected error: %v", si, err)
+		}
+		for j, sj := range bigVersions {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if actual := bi.Compare(semver.MustParseBig(sj)); actual != want {
+				t.Errorf("Compare(%s, %s): expected %d, actual %d", si, sj, want, actual)
+			}
+		}
+	}
+
+	b := semver.MustParseBig("99999999999999999999.2.3-rc.1+b5")
+	if b != (semver.BigVersion{"99999999999999999999", "2", "3", "rc.1", "b5"}) {
+		t.Errorf("Unexpected fields %#v", b)
+	}
+	if b.Short() != "99999999999999999999.2.3-rc.1" {
+		t.Errorf("Short: expected 99999999999999999999.2.3-rc.1, actual %q", b.Short())
+	}
+	if zero := (semver.BigVersion{}); zero.String() != "0.0.0" || zero.Compare(semver.MustParseBig("0.0.0")) != 0 {
+		t.Errorf("Expected the zero BigVersion to be 0.0.0, actual %s", zero)
+	}
+
+	for _, s := range []string{"1.02.3", "1.2", "v1.2.3", "1.2.3-01", "1.2.3+"} {
+		var perr *semver.ParseError
+		if _, err := semver.ParseBig(s); !errors.As(err, &perr) {
+			t.Errorf("ParseBig(%q): expected *ParseError, got %v", s, err)
+		}
+	}
+	for _, b := range []semver.BigVersion{{Major: "01"}, {Minor: "-1"}, {Patch: "1x"}, {Major: "1", PreRelease: "01"}} {
+		if err := b.Validate(); err == nil {
+			t.Errorf("Validate(%#v): expected error", b)
+		}
+	}
+}
+
+// Test the conversions between Version and BigVersion
+func TestBigConversion(t *testing.T) {
+	v := semver.MustParse("9223372036854775807.2.3-rc.1+b5")
+	b := v.Big()
+	if b.String() != v.String() {
+		t.Errorf("Big: expected %s, actual %s", v, b)
+	}
+	if back, err := b.Version(); err != nil || back != v {
+		t.Errorf("Version: expected %s, got %s (%v)", v, back, err)
+	}
+
+	for _, b := range []semver.BigVersion{
+		semver.MustParseBig("9223372036854775808.0.0"),
+		{Major: "1", Minor: "x"},
+	} {
+		if v, err := b.Version(); err == nil {
+			t.Errorf("Version(%s): expected error, got %s", b, v)
+		}
+	}
+	if _, err := semver.Parse("9223372036854775808.0.0"); err == nil {
+		t.Errorf("Parse: expected an error for a number too large for an int")
+	}
+}
+
+// Test that Version is a plain value that works with == and as a map key
+func TestVersionComparable(t *testing.T) {
+	v := semver.MustParse("1.2.3-rc.1+b5")
+	if v != (semver.Version{1, 2, 3, "rc.1", "b5"}) {
+		t.Errorf("Expected == to hold for %s", v)
+	}
+	seen := map[semver.Version]bool{v: true}
+	v.Major = math.MaxInt
+	v.Major = 1
+	if !seen[v] || !seen[semver.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1", Build: "b5"}] {
+		t.Errorf("Expected map lookups of %s to hit", v)
+	}
+	b := map[semver.BigVersion]bool{semver.MustParseBig("99999999999999999999.0.0"): true}
+	if !b[semver.BigVersion{Major: "99999999999999999999", Minor: "0", Patch: "0"}] {
+		t.Errorf("Expected map lookups of a BigVersion to hit")
+	}
+}
+
+// Test that constraints reject numbers too large for an int
+func TestBigConstraints(t *testing.T) {
+	for _, s := range []string{">=99999999999999999999.0.0", "^1.99999999999999999999", "1.2.3 - 99999999999999999999"} {
+		_, err := semver.ParseConstraint(s)
+		var cerr *semver.ConstraintError
+		if !errors.As(err, &cerr) || cerr.Msg != "value out of range" {
+			t.Errorf("ParseConstraint(%q): expected value out of range, got %v", s, err)
+		}
+	}
+}
+
+// Test that BigVersion.Bump carries into numbers too large for an int,
+// and that Version.Bump reports the overflow
+func TestBumpBig(t *testing.T) {
+	testCases := []struct {
+		version  string
+		kind     semver.BumpKind
+		expected string
+	}{
+		{"9223372036854775807.0.0", semver.BumpMajor, "9223372036854775808.0.0"},
+		{"99999999999999999999.5.6", semver.BumpMajor, "100000000000000000000.0.0"},
+		{"99999999999999999999.5.6", semver.BumpMinor, "99999999999999999999.6.0"},
+		{"1.99999999999999999999.6", semver.BumpMinor, "1.100000000000000000000.0"},
+		{"1.2.99999999999999999999", semver.BumpPatch, "1.2.100000000000000000000"},
+		{"1.2.99999999999999999999", semver.BumpPreMinor, "1.3.0-0"},
+		{"1.2.99999999999999999999", semver.BumpPreRelease, "1.2.100000000000000000000-0"},
+		{"1.2.3-rc.1+b5", semver.BumpPreRelease, "1.2.3-rc.2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.version+" "+tc.kind.String(), func(t *testing.T) {
+			actual, err := semver.MustParseBig(tc.version).Bump(tc.kind, semver.BumpOptions{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if actual != semver.MustParseBig(tc.expected) {
+				t.Errorf("Expected %q, actual %q", tc.expected, actual)
+			}
+		})
+	}
+
+	v := semver.Version{Major: math.MaxInt, Minor: 2}
+	if next, err := v.Bump(semver.BumpMajor, semver.BumpOptions{}); err == nil || next != v {
+		t.Errorf("Bump(%s, major): expected an error and the version unchanged, got %s (%v)", v, next, err)
+	}
+	if next, err := v.Bump(semver.BumpMinor, semver.BumpOptions{}); err != nil || next != (semver.Version{Major: math.MaxInt, Minor: 3}) {
+		t.Errorf("Bump(%s, minor): unexpected result %s (%v)", v, next, err)
+	}
+}
+
+// Test that BigVersion is encoded as text
+func TestBigEncoding(t *testing.T) {
+	for _, s := range bigVersions {
+		b := semver.MustParseBig(s)
+		data, err := json.Marshal(b)
+		if err != nil {
+			t.Fatalf("json.Marshal(%s): unexpected error: %v", s, err)
+		}
+		if string(data) != `"`+s+`"` {
+			t.Errorf("json.Marshal(%s): expected %q, actual %s", s, `"`+s+`"`, data)
+		}
+		var decoded semver.BigVersion
+		if err := json.Unmarshal(data, &decoded); err != nil || decoded != b {
+			t.Errorf("JSON round trip of %s: got %s (%v)", s, decoded, err)
+		}
+	}
+	if _, err := json.Marshal(semver.BigVersion{Major: "01"}); err == nil {
+		t.Errorf("json.Marshal: expected an error for an invalid version")
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strings"
+)
+
+// BuildInfoSource supplies the build information that ReadBuildInfo, Commit
+// and Current report. Replacing DefaultBuildInfoSource lets tests feed fake
+// vcs settings, which test binaries never have.
+type BuildInfoSource interface {
+	// ReadBuildInfo returns the build information, or false if there is none.
+	ReadBuildInfo() (*debug.BuildInfo, bool)
+}
+
+// DefaultBuildInfoSource is the source used by ReadBuildInfo, Commit and Current.
+// It reads the running binary unless replaced.
+var DefaultBuildInfoSource BuildInfoSource = RuntimeBuildInfo{}
+
+// RuntimeBuildInfo is a BuildInfoSource that reads the build information
+// embedded in the running binary with runtime/debug.
+type RuntimeBuildInfo struct{}
+
+// ReadBuildInfo implements the BuildInfoSource interface.
+func (RuntimeBuildInfo) ReadBuildInfo() (*debug.BuildInfo, bool) {
+	return debug.ReadBuildInfo()
+}
+
+// StaticBuildInfo is a BuildInfoSource that returns a fixed value,
+// such as one built by hand in a test or returned by ParseBuildInfo.
+// A nil Info reports that there is no build information.
+type StaticBuildInfo struct {
+	Info *debug.BuildInfo
+}
+
+// ReadBuildInfo implements the BuildInfoSource interface.
+func (s StaticBuildInfo) ReadBuildInfo() (*debug.BuildInfo, bool) {
+	return s.Info, s.Info != nil
+}
+
+// ParseBuildInfo parses the text printed by "go version -m" for a single
+// binary, or the output of (*debug.BuildInfo).String.
+//
+// Example input:
+//
+//	/usr/local/bin/app: go1.22.1
+//		path	example.com/app
+//		mod	example.com/app	v1.4.2	h1:...
+//		build	vcs.revision=abc1234def5678
+//		build	vcs.modified=false
+func ParseBuildInfo(data string) (*debug.BuildInfo, error) {
+	data = strings.TrimSpace(data)
+	if data == "" {
+		return nil, fmt.Errorf("semver: parsing build info: empty input")
+	}
+	lines := strings.Split(data, "\n")
+	var goVersion string
+	if first := strings.TrimSpace(lines[0]); !strings.Contains(first, "\t") {
+		// the "go version -m" header is "<file>: <go version>"
+		if i := strings.LastIndex(first, ": "); i != -1 {
+			goVersion, lines = first[i+2:], lines[1:]
+		}
+	}
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(strings.TrimRight(line, "\r"), "\t")
+	}
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "go\t") {
+		// written by (*debug.BuildInfo).String but not read by debug.ParseBuildInfo
+		goVersion, lines = strings.TrimPrefix(lines[0], "go\t"), lines[1:]
+	}
+	info, err := debug.ParseBuildInfo(strings.Join(lines, "\n") + "\n")
+	if err != nil {
+		return nil, fmt.Errorf("semver: parsing build info: %w", err)
+	}
+	if info.GoVersion == "" {
+		info.GoVersion = goVersion
+	}
+	return info, nil
+}
+
+// ReadBuildInfoFrom returns the build information supplied by src.
+// The boolean is false when src has no build information.
+func ReadBuildInfoFrom(src BuildInfoSource) (BuildInfo, bool) {
+	info, ok := src.ReadBuildInfo()
+	if !ok || info == nil {
+		return BuildInfo{}, false
+	}
+	return newBuildInfo(info), true
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"runtime/debug"
+	"testing"
+	"time"
+
+	"github.com/maloquacious/semver"
+)
+
+// goVersionDump is the output of "go version -m" for a binary built from a modified Git checkout.
+const goVersionDump = `/home/user/go/bin/app: go1.22.1
+	path	example.com/app/cmd/app
+	mod	example.com/app	v1.4.2	h1:2Zq8tGmP5LoNNgHYpUqyYu3bmqFrUs1sNYhpLnHU0tU=
+	dep	golang.org/x/mod	v0.17.0	h1:zY54UmvipHiNd+pm+m0x9KhZ9hl1/7QNMyxXbc6ICqA=
+	dep	example.com/old	v1.0.0
+	=>	example.com/new	v1.1.0	h1:ZW5mHQ3rTfGrmbHtt2A0fzCLsVzmJGT5xUmJ3Ke8U8A=
+	build	-buildmode=exe
+	build	-compiler=gc
+	build	CGO_ENABLED=1
+	build	GOOS=linux
+	build	vcs=git
+	build	vcs.revision=5114f85c1d6ab6fe3e2dbd31d0ad2e4b52f0e0c9
+	build	vcs.time=2025-03-14T15:09:26Z
+	build	vcs.modified=true
+`
+
+// useBuildInfo replaces DefaultBuildInfoSource for the rest of the test.
+func useBuildInfo(t *testing.T, src semver.BuildInfoSource) {
+	t.Helper()
+	saved := semver.DefaultBuildInfoSource
+	semver.DefaultBuildInfoSource = src
+	t.Cleanup(func() { semver.DefaultBuildInfoSource = saved })
+}
+
+// Test for ParseBuildInfo with "go version -m" output
+func TestParseBuildInfo(t *testing.T) {
+	info, err := semver.ParseBuildInfo(goVersionDump)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	useBuildInfo(t, semver.StaticBuildInfo{Info: info})
+
+	b, ok := semver.ReadBuildInfo()
+	if !ok {
+		t.Fatalf("Expected build info")
+	}
+	if b.GoVersion != "go1.22.1" {
+		t.Errorf("GoVersion: expected %q, actual %q", "go1.22.1", b.GoVersion)
+	}
+	if b.MainModule.Path != "example.com/app" || b.MainModule.Version != "v1.4.2" {
+		t.Errorf("MainModule: expected example.com/app v1.4.2, actual %s %s", b.MainModule.Path, b.MainModule.Version)
+	}
+	if len(b.Deps) != 2 || b.Deps[1].Replace == nil || b.Deps[1].Replace.Path != "example.com/new" {
+		t.Errorf("Deps: unexpected %+v", b.Deps)
+	}
+	if b.VCS != "git" || b.Revision != "5114f85c1d6ab6fe3e2dbd31d0ad2e4b52f0e0c9" || b.ShortRevision != "5114f85" || !b.Modified {
+		t.Errorf("VCS settings: unexpected %+v", b)
+	}
+	if expected := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC); !b.Time.Equal(expected) {
+		t.Errorf("Time: expected %v, actual %v", expected, b.Time)
+	}
+	if actual := semver.Commit(); actual != "5114f85-dirty" {
+		t.Errorf("Commit: expected %q, actual %q", "5114f85-dirty", actual)
+	}
+	if actual := semver.Current().Build; actual != "5114f85-dirty" {
+		t.Errorf("Current().Build: expected %q, actual %q", "5114f85-dirty", actual)
+	}
+}
+
+// Test that ParseBuildInfo reads the output of debug.BuildInfo.String
+func TestParseBuildInfoString(t *testing.T) {
+	info := &debug.BuildInfo{
+		GoVersion: "go1.21.6",
+		Path:      "example.com/app",
+		Main:      debug.Module{Path: "example.com/app", Version: "(devel)"},
+		Settings:  []debug.BuildSetting{{Key: "vcs.revision", Value: "abc1234def"}, {Key: "vcs.modified", Value: "false"}},
+	}
+	parsed, err := semver.ParseBuildInfo(info.String())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if parsed, err := semver.ParseBuildInfo("app: go1.22.1"); err != nil || parsed.GoVersion != "go1.22.1" {
+		t.Errorf("Expected go1.22.1 from header only, got %v (%v)", parsed, err)
+	}
+	if parsed.String() != info.String() {
+		t.Errorf("Expected %q, actual %q", info.String(), parsed.String())
+	}
+	if b, _ := semver.ReadBuildInfoFrom(semver.StaticBuildInfo{Info: parsed}); b.Commit() != "abc1234" {
+		t.Errorf("Commit: expected %q, actual %q", "abc1234", b.Commit())
+	}
+}
+
+// Test for ParseBuildInfo with invalid input
+func TestParseBuildInfoError(t *testing.T) {
+	for _, input := range []string{"", "app: go1.22.1\n\tmod\texample.com/app", "app: go1.22.1\n\tbuild\tnovalue"} {
+		if _, err := semver.ParseBuildInfo(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+// Test for a source without build information
+func TestNoBuildInfo(t *testing.T) {
+	useBuildInfo(t, semver.StaticBuildInfo{})
+	if _, ok := semver.ReadBuildInfo(); ok {
+		t.Errorf("Expected no build info")
+	}
+	if actual := semver.Commit(); actual != "" {
+		t.Errorf("Commit: expected empty string, actual %q", actual)
+	}
+	if actual := semver.Current(); actual.Build != "" {
+		t.Errorf("Current: expected no build metadata, actual %q", actual)
+	}
+}
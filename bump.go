@@ -0,0 +1,219 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BumpKind selects which part of a version Bump increments.
+// The kinds and their behavior mirror the release types of node-semver's inc.
+type BumpKind int
+
+const (
+	BumpMajor      BumpKind = iota // next major release; 1.2.3 → 2.0.0, 2.0.0-rc.1 → 2.0.0
+	BumpMinor                      // next minor release; 1.2.3 → 1.3.0, 1.3.0-rc.1 → 1.3.0
+	BumpPatch                      // next patch release; 1.2.3 → 1.2.4, 1.2.3-rc.1 → 1.2.3
+	BumpPreMajor                   // first pre-release of the next major; 1.2.3 → 2.0.0-0
+	BumpPreMinor                   // first pre-release of the next minor; 1.2.3 → 1.3.0-0
+	BumpPrePatch                   // first pre-release of the next patch; 1.2.3 → 1.2.4-0
+	BumpPreRelease                 // next pre-release; 1.2.3 → 1.2.4-0, 1.2.3-rc.1 → 1.2.3-rc.2
+)
+
+// String implements the fmt.Stringer interface.
+// It returns the node-semver name of the kind, such as "premajor".
+func (k BumpKind) String() string {
+	switch k {
+	case BumpMajor:
+		return "major"
+	case BumpMinor:
+		return "minor"
+	case BumpPatch:
+		return "patch"
+	case BumpPreMajor:
+		return "premajor"
+	case BumpPreMinor:
+		return "preminor"
+	case BumpPrePatch:
+		return "prepatch"
+	case BumpPreRelease:
+		return "prerelease"
+	}
+	return fmt.Sprintf("BumpKind(%d)", int(k))
+}
+
+// ParseBumpKind returns the BumpKind with the given node-semver name,
+// such as "minor" or "prerelease". Case is ignored.
+func ParseBumpKind(s string) (BumpKind, error) {
+	for k := BumpMajor; k <= BumpPreRelease; k++ {
+		if strings.EqualFold(s, k.String()) {
+			return k, nil
+		}
+	}
+	return 0, fmt.Errorf("semver: unknown bump kind %q", s)
+}
+
+// BumpOptions controls the pre-release and build metadata of a bumped version.
+// The zero value numbers pre-releases from "0" and clears build metadata.
+type BumpOptions struct {
+	// PreReleaseID is the identifier that leads new pre-releases, such as
+	// "rc" to produce "rc.0" instead of "0". It is ignored by the major,
+	// minor and patch kinds. It must be a valid pre-release identifier list.
+	PreReleaseID string
+	// KeepBuild keeps the build metadata of the original version.
+	// By default the bumped version has none, because the metadata
+	// describes a build of the original version.
+	KeepBuild bool
+}
+
+// Bump returns the version that follows v for the given kind, using the
+// same rules as node-semver's inc:
+//
+//   - major, minor and patch release a pre-release of the requested core
+//     instead of skipping it, so patch turns 1.2.3-rc.1 into 1.2.3, and
+//     minor turns 1.3.0-rc.1 into 1.3.0 but 1.2.3-rc.1 into 1.3.0.
+//   - premajor, preminor and prepatch always increment the core and start
+//     a new pre-release.
+//   - prerelease increments the last numeric identifier of the pre-release
+//     (rc.1 → rc.2, or rc → rc.0 if there is none), and starts a pre-release
+//     of the next patch for a normal release.
+//
+// When opts.PreReleaseID is set, new pre-releases are PreReleaseID.0, and
+// prerelease replaces a pre-release that is not already PreReleaseID.N,
+// so prerelease with "beta" turns 1.2.3-alpha.4 into 1.2.3-beta.0 and
+// 1.2.3-beta.4 into 1.2.3-beta.5.
+//
+// It returns the error from Validate for an invalid v, such as one with a
+// negative number, and an error for an unknown kind, an invalid PreReleaseID,
+// or a number that would grow past math.MaxInt; BigVersion.Bump has no such limit.
+func (v Version) Bump(kind BumpKind, opts BumpOptions) (Version, error) {
+	if err := v.Validate(); err != nil {
+		return v, err
+	}
+	big, err := v.Big().Bump(kind, opts)
+	if err != nil {
+		return v, err
+	}
+	next, err := big.Version()
+	if err != nil {
+		return v, err
+	}
+	return next, nil
+}
+
+// Bump returns the version that follows b for the given kind, with the
+// rules of Version.Bump. The numbers are incremented as digits, so they
+// can grow past an int. It returns the error from Validate for an invalid b.
+func (b BigVersion) Bump(kind BumpKind, opts BumpOptions) (BigVersion, error) {
+	if err := b.Validate(); err != nil {
+		return b, err
+	}
+	if opts.PreReleaseID != "" {
+		if offset, msg := checkIdentifiers(opts.PreReleaseID, true); offset != -1 {
+			return b, fmt.Errorf("semver: invalid pre-release identifier %q at offset %d: %s", opts.PreReleaseID, offset, msg)
+		}
+	}
+	next := BigVersion{Major: bigNumber(b.Major), Minor: bigNumber(b.Minor), Patch: bigNumber(b.Patch)}
+	if opts.KeepBuild {
+		next.Build = b.Build
+	}
+	isPreRelease := b.PreRelease != ""
+
+	switch kind {
+	case BumpMajor:
+		if !isPreRelease || next.Minor != "0" || next.Patch != "0" {
+			next.Major, next.Minor, next.Patch = incrementDigits(next.Major), "0", "0"
+		}
+	case BumpMinor:
+		if !isPreRelease || next.Patch != "0" {
+			next.Minor, next.Patch = incrementDigits(next.Minor), "0"
+		}
+	case BumpPatch:
+		if !isPreRelease {
+			next.Patch = incrementDigits(next.Patch)
+		}
+	case BumpPreMajor:
+		next.Major, next.Minor, next.Patch = incrementDigits(next.Major), "0", "0"
+		next.PreRelease = nextPreRelease("", opts.PreReleaseID)
+	case BumpPreMinor:
+		next.Minor, next.Patch = incrementDigits(next.Minor), "0"
+		next.PreRelease = nextPreRelease("", opts.PreReleaseID)
+	case BumpPrePatch:
+		next.Patch = incrementDigits(next.Patch)
+		next.PreRelease = nextPreRelease("", opts.PreReleaseID)
+	case BumpPreRelease:
+		if !isPreRelease {
+			next.Patch = incrementDigits(next.Patch)
+		}
+		next.PreRelease = nextPreRelease(b.PreRelease, opts.PreReleaseID)
+	default:
+		return b, fmt.Errorf("semver: unknown bump kind %v", kind)
+	}
+	return next, nil
+}
+
+// NextMajor returns the next major release of v.
+// It is shorthand for Bump(BumpMajor, BumpOptions{}), and returns v
+// unchanged if Bump fails, as it does for an invalid v.
+func (v Version) NextMajor() Version {
+	next, _ := v.Bump(BumpMajor, BumpOptions{})
+	return next
+}
+
+// NextMinor returns the next minor release of v.
+// It is shorthand for Bump(BumpMinor, BumpOptions{}), and returns v
+// unchanged if Bump fails, as it does for an invalid v.
+func (v Version) NextMinor() Version {
+	next, _ := v.Bump(BumpMinor, BumpOptions{})
+	return next
+}
+
+// NextPatch returns the next patch release of v.
+// It is shorthand for Bump(BumpPatch, BumpOptions{}), and returns v
+// unchanged if Bump fails, as it does for an invalid v.
+func (v Version) NextPatch() Version {
+	next, _ := v.Bump(BumpPatch, BumpOptions{})
+	return next
+}
+
+// nextPreRelease returns the pre-release that follows pre, which is empty
+// when a new pre-release is being started, optionally led by id.
+func nextPreRelease(pre, id string) string {
+	first := "0"
+	if id != "" {
+		first = id + ".0"
+	}
+	if pre == "" {
+		return first
+	}
+	if id != "" {
+		// keep the numbering of a pre-release that is already id.N
+		if fields := strings.Split(pre, "."); fields[0] != id || len(fields) < 2 || !isNumeric(fields[1]) {
+			return first
+		}
+	}
+
+	fields := strings.Split(pre, ".")
+	for i := len(fields) - 1; i >= 0; i-- {
+		if isNumeric(fields[i]) {
+			fields[i] = incrementDigits(fields[i])
+			return strings.Join(fields, ".")
+		}
+	}
+	return pre + ".0"
+}
+
+// incrementDigits adds one to a string of decimal digits. It works on the
+// digits directly so that numbers larger than an int don't overflow.
+func incrementDigits(s string) string {
+	b := []byte(s)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] != '9' {
+			b[i]++
+			return string(b)
+		}
+		b[i] = '0'
+	}
+	return "1" + string(b)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for Version.Bump
+func TestBump(t *testing.T) {
+	testCases := []struct {
+		version  string
+		kind     semver.BumpKind
+		id       string
+		expected string
+	}{
+		// examples from node-semver's increment tests
+		{"1.2.3", semver.BumpMajor, "", "2.0.0"},
+		{"1.2.3", semver.BumpMinor, "", "1.3.0"},
+		{"1.2.3", semver.BumpPatch, "", "1.2.4"},
+		{"1.2.3-tag", semver.BumpMajor, "", "2.0.0"},
+		{"1.2.0-0", semver.BumpPatch, "", "1.2.0"},
+		{"1.2.3-4", semver.BumpMajor, "", "2.0.0"},
+		{"1.2.3-4", semver.BumpMinor, "", "1.3.0"},
+		{"1.2.3-4", semver.BumpPatch, "", "1.2.3"},
+		{"1.2.3-alpha.0.beta", semver.BumpMajor, "", "2.0.0"},
+		{"1.2.3-alpha.0.beta", semver.BumpMinor, "", "1.3.0"},
+		{"1.2.3-alpha.0.beta", semver.BumpPatch, "", "1.2.3"},
+		{"1.2.4", semver.BumpPreRelease, "", "1.2.5-0"},
+		{"1.2.3-0", semver.BumpPreRelease, "", "1.2.3-1"},
+		{"1.2.3-alpha.0", semver.BumpPreRelease, "", "1.2.3-alpha.1"},
+		{"1.2.3-alpha.1", semver.BumpPreRelease, "", "1.2.3-alpha.2"},
+		{"1.2.3-alpha.2", semver.BumpPreRelease, "", "1.2.3-alpha.3"},
+		{"1.2.3-alpha.0.beta", semver.BumpPreRelease, "", "1.2.3-alpha.1.beta"},
+		{"1.2.3-alpha.1.beta", semver.BumpPreRelease, "", "1.2.3-alpha.2.beta"},
+		{"1.2.3-alpha.10.0.beta", semver.BumpPreRelease, "", "1.2.3-alpha.10.1.beta"},
+		{"1.2.3-alpha.9.beta", semver.BumpPreRelease, "", "1.2.3-alpha.10.beta"},
+		{"1.2.3-alpha.beta", semver.BumpPreRelease, "", "1.2.3-alpha.beta.0"},
+		{"1.2.0", semver.BumpPrePatch, "", "1.2.1-0"},
+		{"1.2.0-1", semver.BumpPrePatch, "", "1.2.1-0"},
+		{"1.2.0", semver.BumpPreMinor, "", "1.3.0-0"},
+		{"1.2.3-1", semver.BumpPreMinor, "", "1.3.0-0"},
+		{"1.2.0", semver.BumpPreMajor, "", "2.0.0-0"},
+		{"1.2.3-1", semver.BumpPreMajor, "", "2.0.0-0"},
+		{"1.2.0-1", semver.BumpMinor, "", "1.2.0"},
+		{"1.0.0-1", semver.BumpMajor, "", "1.0.0"},
+		{"1.2.4", semver.BumpPreRelease, "dev", "1.2.5-dev.0"},
+		{"1.2.3-0", semver.BumpPreRelease, "dev", "1.2.3-dev.0"},
+		{"1.2.3-alpha.0", semver.BumpPreRelease, "dev", "1.2.3-dev.0"},
+		{"1.2.3-alpha.0", semver.BumpPreRelease, "alpha", "1.2.3-alpha.1"},
+		{"1.2.3-alpha.0.beta", semver.BumpPreRelease, "dev", "1.2.3-dev.0"},
+		{"1.2.3-alpha.0.beta", semver.BumpPreRelease, "alpha", "1.2.3-alpha.1.beta"},
+		{"1.2.3-alpha.beta", semver.BumpPreRelease, "alpha", "1.2.3-alpha.0"},
+		{"1.2.3-rc", semver.BumpPreRelease, "rc", "1.2.3-rc.0"},
+		{"1.2.0", semver.BumpPrePatch, "dev", "1.2.1-dev.0"},
+		{"1.2.0-1", semver.BumpPrePatch, "dev", "1.2.1-dev.0"},
+		{"1.2.0", semver.BumpPreMinor, "dev", "1.3.0-dev.0"},
+		{"1.2.3-1", semver.BumpPreMinor, "dev", "1.3.0-dev.0"},
+		{"1.2.0", semver.BumpPreMajor, "dev", "2.0.0-dev.0"},
+		{"1.2.3-1", semver.BumpPreMajor, "dev", "2.0.0-dev.0"},
+		{"1.2.0-1", semver.BumpMinor, "dev", "1.2.0"},
+		{"1.2.3-1.99999999999999999999", semver.BumpPreRelease, "", "1.2.3-1.100000000000000000000"},
+		{"1.2.3+build.7", semver.BumpPatch, "", "1.2.4"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.version+" "+tc.kind.String()+" "+tc.id, func(t *testing.T) {
+			actual, err := semver.MustParse(tc.version).Bump(tc.kind, semver.BumpOptions{PreReleaseID: tc.id})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if actual.String() != tc.expected {
+				t.Errorf("Unexpected version. expected: %q, actual: %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+// Test for Version.Bump with BumpOptions.KeepBuild and invalid input
+func TestBumpOptions(t *testing.T) {
+	v := semver.MustParse("1.2.3-rc.1+sha.5114f85")
+	if actual, err := v.Bump(semver.BumpPreRelease, semver.BumpOptions{KeepBuild: true}); err != nil || actual.String() != "1.2.3-rc.2+sha.5114f85" {
+		t.Errorf("Expected 1.2.3-rc.2+sha.5114f85, got %q (%v)", actual, err)
+	}
+	for _, id := range []string{"rc..1", "01", "rc_1"} {
+		if _, err := v.Bump(semver.BumpPreRelease, semver.BumpOptions{PreReleaseID: id}); err == nil {
+			t.Errorf("Expected error for pre-release identifier %q", id)
+		}
+	}
+	if _, err := v.Bump(semver.BumpKind(99), semver.BumpOptions{}); err == nil {
+		t.Errorf("Expected error for unknown kind")
+	}
+}
+
+// Test that Bump rejects versions with negative numbers
+func TestBumpNegative(t *testing.T) {
+	for _, v := range []semver.Version{{Major: -1}, {Major: 1, Minor: -5}, {Major: 1, Patch: -1, PreRelease: "rc.1"}} {
+		for k := semver.BumpMajor; k <= semver.BumpPreRelease; k++ {
+			next, err := v.Bump(k, semver.BumpOptions{})
+			var verr *semver.ValidationError
+			if !errors.As(err, &verr) || next != v {
+				t.Errorf("Bump(%s, %s): expected a *ValidationError and the version unchanged, got %s (%v)", v, k, next, err)
+			}
+		}
+		if actual := v.NextMajor(); actual != v {
+			t.Errorf("NextMajor(%s): expected the version unchanged, actual %s", v, actual)
+		}
+	}
+	b := semver.BigVersion{Major: "1", Minor: "-5"}
+	if next, err := b.Bump(semver.BumpMinor, semver.BumpOptions{}); err == nil || next != b {
+		t.Errorf("Bump(%s, minor): expected an error, got %s (%v)", b, next, err)
+	}
+}
+
+// Test for NextMajor, NextMinor and NextPatch
+func TestNext(t *testing.T) {
+	v := semver.MustParse("1.2.3-rc.1+build")
+	if actual := v.NextMajor().String(); actual != "2.0.0" {
+		t.Errorf("NextMajor: expected 2.0.0, actual %q", actual)
+	}
+	if actual := v.NextMinor().String(); actual != "1.3.0" {
+		t.Errorf("NextMinor: expected 1.3.0, actual %q", actual)
+	}
+	if actual := v.NextPatch().String(); actual != "1.2.3" {
+		t.Errorf("NextPatch: expected 1.2.3, actual %q", actual)
+	}
+}
+
+// Test for ParseBumpKind
+func TestParseBumpKind(t *testing.T) {
+	for k := semver.BumpMajor; k <= semver.BumpPreRelease; k++ {
+		if actual, err := semver.ParseBumpKind(k.String()); err != nil || actual != k {
+			t.Errorf("ParseBumpKind(%q): expected %v, actual %v (%v)", k.String(), k, actual, err)
+		}
+	}
+	if _, err := semver.ParseBumpKind("release"); err == nil {
+		t.Errorf("Expected error for unknown kind")
+	}
+}
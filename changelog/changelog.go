@@ -0,0 +1,370 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+// Package changelog reads and writes CHANGELOG.md files in the Keep a
+// Changelog format (https://keepachangelog.com/en/1.1.0/):
+//
+//	# Changelog
+//
+//	## [Unreleased]
+//
+//	## [1.1.0] - 2025-03-14
+//
+//	### Added
+//
+//	- The sort command.
+//
+//	## [1.0.0] - 2025-01-29 [YANKED]
+//
+//	[unreleased]: https://example.com/compare/v1.1.0...HEAD
+//
+// Releases are keyed by semver.Version and must appear in descending order
+// of precedence with no duplicates. Render writes a changelog in a fixed
+// layout, so rendering a parsed changelog and parsing it again gives the
+// same changelog.
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/maloquacious/semver"
+)
+
+// DateFormat is the layout of release dates, ISO 8601 as Keep a Changelog asks for.
+const DateFormat = "2006-01-02"
+
+// The section names recommended by Keep a Changelog, in the order Render
+// and NewRelease use. Other names are allowed and follow these.
+const (
+	Added      = "Added"
+	Changed    = "Changed"
+	Deprecated = "Deprecated"
+	Removed    = "Removed"
+	Fixed      = "Fixed"
+	Security   = "Security"
+)
+
+// sectionOrder lists the recommended sections in order.
+var sectionOrder = []string{Added, Changed, Deprecated, Removed, Fixed, Security}
+
+// Changelog is a parsed CHANGELOG.md.
+type Changelog struct {
+	Preamble   string    // the text before the first release, such as the title and introduction
+	Unreleased *Release  // the Unreleased release, or nil if there is none
+	Releases   []Release // the releases, highest version first
+	Links      []Link    // the link reference definitions at the end of the file
+}
+
+// Release is one release of a changelog, or the Unreleased changes.
+type Release struct {
+	Version    semver.Version // the version; the zero Version for Unreleased
+	Unreleased bool           // this is the Unreleased heading
+	Date       time.Time      // the release date, or the zero time if there is none
+	Yanked     bool           // the release was pulled, marked "[YANKED]"
+	Notes      string         // text between the heading and the first section
+	Sections   []Section      // the change sections, in order
+
+	line int // the line of the heading, for errors
+}
+
+// Section is a group of changes, such as "Added" or "Fixed".
+type Section struct {
+	Name    string   // the heading, such as "Added"
+	Entries []string // the list items, without the "- " marker; continuation lines are joined with "\n"
+}
+
+// Link is a link reference definition, such as "[1.0.0]: https://...".
+type Link struct {
+	Label string
+	URL   string
+}
+
+// Entry is a change to be added to a release by NewRelease.
+type Entry struct {
+	Section string // the section name, such as Added
+	Text    string // the description of the change
+}
+
+var (
+	releaseHeading = regexp.MustCompile(`^\[?([^\]\s]+)\]?(?:\s+[-–]\s+(\d{4}-\d{2}-\d{2}))?(\s+\[YANKED\])?$`)
+	linkDefinition = regexp.MustCompile(`^\[([^\]]+)\]:\s*(\S+)\s*$`)
+)
+
+// Parse parses a changelog. Release headings are "## [1.2.3] - 2025-01-29",
+// optionally followed by "[YANKED]"; the brackets, the date and a leading
+// "v" on the version are optional. Sections are "### " headings holding
+// lists marked with "-" or "*".
+//
+// It returns an error, with the line number, for a heading that is not a
+// version or Unreleased, a date that is not YYYY-MM-DD, text in a section
+// that is not a list item, and releases that Validate rejects.
+func Parse(text string) (*Changelog, error) {
+	c := &Changelog{}
+	var preamble []string
+	var release *Release
+	var section *Section
+	var notes []string
+	continuation := false // the previous line belongs to a list item
+
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		n := i + 1
+		trimmed := strings.TrimSpace(line)
+		if release == nil && !strings.HasPrefix(line, "## ") {
+			preamble = append(preamble, line)
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "## "):
+			if release != nil {
+				release.Notes = strings.TrimSpace(strings.Join(notes, "\n"))
+				c.add(*release)
+			}
+			if len(c.Links) != 0 {
+				return nil, fmt.Errorf("changelog: line %d: release after the link definitions", n)
+			}
+			r, err := parseHeading(strings.TrimSpace(line[3:]), n)
+			if err != nil {
+				return nil, err
+			}
+			release, section, notes, continuation = &r, nil, nil, false
+		case strings.HasPrefix(line, "### "):
+			release.Sections = append(release.Sections, Section{Name: strings.TrimSpace(line[4:])})
+			section, continuation = &release.Sections[len(release.Sections)-1], false
+		case trimmed == "":
+			continuation = false
+		case linkDefinition.MatchString(trimmed):
+			m := linkDefinition.FindStringSubmatch(trimmed)
+			c.Links = append(c.Links, Link{Label: m[1], URL: m[2]})
+		case len(c.Links) != 0:
+			return nil, fmt.Errorf("changelog: line %d: text after the link definitions", n)
+		case section == nil:
+			notes = append(notes, line)
+		case strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ") || trimmed == "-" || trimmed == "*":
+			section.Entries = append(section.Entries, strings.TrimSpace(trimmed[1:]))
+			continuation = true
+		case continuation:
+			section.Entries[len(section.Entries)-1] += "\n" + trimmed
+		default:
+			return nil, fmt.Errorf("changelog: line %d: expected a list item in section %q", n, section.Name)
+		}
+	}
+	if release != nil {
+		release.Notes = strings.TrimSpace(strings.Join(notes, "\n"))
+		c.add(*release)
+	}
+	c.Preamble = strings.TrimSpace(strings.Join(preamble, "\n"))
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// parseHeading parses the text of a "## " heading.
+func parseHeading(s string, line int) (Release, error) {
+	r := Release{line: line}
+	m := releaseHeading.FindStringSubmatch(s)
+	if m == nil {
+		return r, fmt.Errorf("changelog: line %d: invalid release heading %q", line, s)
+	}
+	if strings.EqualFold(m[1], "Unreleased") {
+		r.Unreleased = true
+	} else {
+		v, err := semver.Parse(strings.TrimPrefix(m[1], "v"))
+		if err != nil {
+			return r, fmt.Errorf("changelog: line %d: %w", line, err)
+		}
+		r.Version = v
+	}
+	if m[2] != "" {
+		date, err := time.Parse(DateFormat, m[2])
+		if err != nil {
+			return r, fmt.Errorf("changelog: line %d: invalid date %q", line, m[2])
+		}
+		r.Date = date
+	}
+	r.Yanked = m[3] != ""
+	return r, nil
+}
+
+// add appends a parsed release; ordering is checked later by Validate.
+func (c *Changelog) add(r Release) {
+	if r.Unreleased && c.Unreleased == nil && len(c.Releases) == 0 {
+		c.Unreleased = &r
+		return
+	}
+	c.Releases = append(c.Releases, r)
+}
+
+// Validate checks that the releases are valid versions in descending order
+// of precedence with no duplicates, and that Unreleased, if present, is
+// only listed once and before every release.
+func (c *Changelog) Validate() error {
+	for i, r := range c.Releases {
+		at := ""
+		if r.line != 0 {
+			at = fmt.Sprintf("line %d: ", r.line)
+		}
+		if r.Unreleased {
+			return fmt.Errorf("changelog: %sUnreleased must be listed once, before every release", at)
+		} else if err := r.Version.Validate(); err != nil {
+			return fmt.Errorf("changelog: %s%w", at, err)
+		}
+		if i == 0 {
+			continue
+		}
+		switch prev := c.Releases[i-1].Version; r.Version.Compare(prev) {
+		case 0:
+			return fmt.Errorf("changelog: %sduplicate release %s", at, r.Version)
+		case 1:
+			return fmt.Errorf("changelog: %srelease %s is listed after the lower release %s", at, r.Version, prev)
+		}
+	}
+	return nil
+}
+
+// Find returns the release with the same precedence as v.
+func (c *Changelog) Find(v semver.Version) (*Release, bool) {
+	for i := range c.Releases {
+		if c.Releases[i].Version.Compare(v) == 0 {
+			return &c.Releases[i], true
+		}
+	}
+	return nil, false
+}
+
+// Insert adds a release in its place by version order. It returns an error
+// if the changelog already has a release with the same precedence, or if r
+// is Unreleased and the changelog already has Unreleased changes.
+func (c *Changelog) Insert(r Release) error {
+	r.line = 0
+	if r.Unreleased {
+		if c.Unreleased != nil {
+			return fmt.Errorf("changelog: there already are Unreleased changes")
+		}
+		c.Unreleased = &r
+		return nil
+	}
+	if err := r.Version.Validate(); err != nil {
+		return fmt.Errorf("changelog: %w", err)
+	}
+	i, found := slices.BinarySearchFunc(c.Releases, r.Version, func(e Release, v semver.Version) int {
+		return v.Compare(e.Version) // descending
+	})
+	if found {
+		return fmt.Errorf("changelog: duplicate release %s", r.Version)
+	}
+	c.Releases = slices.Insert(c.Releases, i, r)
+	return nil
+}
+
+// Promote releases the Unreleased changes as version v on date, leaving an
+// empty Unreleased heading in place for the next changes, as Keep a
+// Changelog recommends. It returns the same errors as Insert.
+func (c *Changelog) Promote(v semver.Version, date time.Time) error {
+	r := Release{Version: v, Date: date}
+	if c.Unreleased != nil {
+		r.Notes, r.Sections = c.Unreleased.Notes, c.Unreleased.Sections
+	}
+	if err := c.Insert(r); err != nil {
+		return err
+	}
+	c.Unreleased = &Release{Unreleased: true}
+	return nil
+}
+
+// NewRelease returns the release of version v on date with the entries
+// grouped into sections. The recommended sections come first, in the order
+// Added, Changed, Deprecated, Removed, Fixed, Security, followed by other
+// sections in the order they first appear. Entries keep their order.
+func NewRelease(v semver.Version, date time.Time, entries []Entry) Release {
+	r := Release{Version: v, Date: date}
+	var names []string
+	grouped := make(map[string][]string)
+	for _, e := range entries {
+		if _, ok := grouped[e.Section]; !ok {
+			names = append(names, e.Section)
+		}
+		grouped[e.Section] = append(grouped[e.Section], e.Text)
+	}
+	rank := func(name string) int {
+		if i := slices.Index(sectionOrder, name); i != -1 {
+			return i
+		}
+		return len(sectionOrder)
+	}
+	slices.SortStableFunc(names, func(a, b string) int {
+		return rank(a) - rank(b)
+	})
+	for _, name := range names {
+		r.Sections = append(r.Sections, Section{Name: name, Entries: grouped[name]})
+	}
+	return r
+}
+
+// Render writes the changelog in a fixed layout: blank lines around every
+// heading, list items marked with "-" and continuation lines indented by
+// two spaces, and the link definitions at the end.
+func (c *Changelog) Render() string {
+	var sb strings.Builder
+	if c.Preamble != "" {
+		sb.WriteString(c.Preamble)
+		sb.WriteString("\n")
+	}
+	releases := c.Releases
+	if c.Unreleased != nil {
+		releases = append([]Release{*c.Unreleased}, releases...)
+	}
+	for _, r := range releases {
+		if sb.Len() != 0 {
+			sb.WriteString("\n")
+		}
+		r.render(&sb)
+	}
+	if len(c.Links) != 0 {
+		if sb.Len() != 0 {
+			sb.WriteString("\n")
+		}
+		for _, link := range c.Links {
+			fmt.Fprintf(&sb, "[%s]: %s\n", link.Label, link.URL)
+		}
+	}
+	return sb.String()
+}
+
+// String returns the heading text of the release, such as "[1.2.3] - 2025-01-29".
+func (r Release) String() string {
+	s := "[Unreleased]"
+	if !r.Unreleased {
+		s = "[" + r.Version.String() + "]"
+		if !r.Date.IsZero() {
+			s += " - " + r.Date.Format(DateFormat)
+		}
+	}
+	if r.Yanked {
+		s += " [YANKED]"
+	}
+	return s
+}
+
+// render writes the release.
+func (r Release) render(sb *strings.Builder) {
+	fmt.Fprintf(sb, "## %s\n", r)
+	if r.Notes != "" {
+		fmt.Fprintf(sb, "\n%s\n", r.Notes)
+	}
+	for _, s := range r.Sections {
+		fmt.Fprintf(sb, "\n### %s\n", s.Name)
+		if len(s.Entries) != 0 {
+			sb.WriteString("\n")
+		}
+		for _, e := range s.Entries {
+			fmt.Fprintf(sb, "- %s\n", strings.ReplaceAll(e, "\n", "\n  "))
+		}
+	}
+}
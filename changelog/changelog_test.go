@@ -0,0 +1,197 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package changelog_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maloquacious/semver"
+	"github.com/maloquacious/semver/changelog"
+)
+
+// sample is a changelog in the layout that Render produces.
+const sample = `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+## [Unreleased]
+
+### Fixed
+
+- Sort versions that differ only in build metadata stably.
+
+## [1.1.0] - 2025-03-14
+
+### Added
+
+- The sort command.
+- Large version numbers, such as
+  99999999999999999999.0.0.
+
+### Security
+
+- Reject control characters.
+
+## [1.0.0] - 2025-01-29 [YANKED]
+
+Pulled because of a broken go.mod.
+
+### Changed
+
+- First stable release.
+
+## [1.0.0-rc.1]
+
+[unreleased]: https://example.com/compare/v1.1.0...HEAD
+[1.1.0]: https://example.com/compare/v1.0.0...v1.1.0
+`
+
+// Test for Parse
+func TestParse(t *testing.T) {
+	c, err := changelog.Parse(sample)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(c.Preamble, "# Changelog\n\nAll notable") {
+		t.Errorf("Unexpected preamble %q", c.Preamble)
+	}
+	if c.Unreleased == nil || len(c.Unreleased.Sections) != 1 || c.Unreleased.Sections[0].Name != changelog.Fixed {
+		t.Errorf("Unexpected Unreleased %+v", c.Unreleased)
+	}
+
+	expected := []struct {
+		heading  string
+		sections int
+	}{
+		{"[1.1.0] - 2025-03-14", 2},
+		{"[1.0.0] - 2025-01-29 [YANKED]", 1},
+		{"[1.0.0-rc.1]", 0},
+	}
+	if len(c.Releases) != len(expected) {
+		t.Fatalf("Expected %d releases, actual %d", len(expected), len(c.Releases))
+	}
+	for i, e := range expected {
+		if r := c.Releases[i]; r.String() != e.heading || len(r.Sections) != e.sections {
+			t.Errorf("Release %d: expected %s with %d sections, actual %s with %d", i, e.heading, e.sections, r, len(r.Sections))
+		}
+	}
+
+	r, ok := c.Find(semver.MustParse("1.1.0"))
+	if !ok || r.Date != time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("Find(1.1.0): expected the 2025-03-14 release, actual %+v", r)
+	}
+	if entry := r.Sections[0].Entries[1]; entry != "Large version numbers, such as\n99999999999999999999.0.0." {
+		t.Errorf("Expected a continued entry, actual %q", entry)
+	}
+	if r, _ := c.Find(semver.MustParse("1.0.0")); !r.Yanked || r.Notes != "Pulled because of a broken go.mod." {
+		t.Errorf("Expected a yanked release with notes, actual %+v", r)
+	}
+	if _, ok := c.Find(semver.MustParse("2.0.0")); ok {
+		t.Errorf("Find(2.0.0): expected no release")
+	}
+	if len(c.Links) != 2 || c.Links[0].Label != "unreleased" || c.Links[1].URL != "https://example.com/compare/v1.0.0...v1.1.0" {
+		t.Errorf("Unexpected links %+v", c.Links)
+	}
+}
+
+// Test that Render reproduces its own layout and normalizes other layouts
+func TestRender(t *testing.T) {
+	c, err := changelog.Parse(sample)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if actual := c.Render(); actual != sample {
+		t.Errorf("Expected\n%s\nactual\n%s", sample, actual)
+	}
+
+	loose := "# Changelog\n## Unreleased\n## v1.0.0 – 2025-01-29\n### Added\n* one\n* two\n  continued\n"
+	c, err = changelog.Parse(loose)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "# Changelog\n\n## [Unreleased]\n\n## [1.0.0] - 2025-01-29\n\n### Added\n\n- one\n- two\n  continued\n"
+	if actual := c.Render(); actual != expected {
+		t.Errorf("Expected\n%s\nactual\n%s", expected, actual)
+	}
+}
+
+// Test that Parse rejects malformed changelogs with the line of the problem
+func TestParseErrors(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		text  string
+		error string
+	}{
+		{"not a version", "## [next]\n", "line 1: semver: invalid major"},
+		{"bad date", "## [1.0.0] - 2025-13-01\n", `line 1: invalid date "2025-13-01"`},
+		{"bad heading", "## [1.0.0] on 2025-01-01\n", "line 1: invalid release heading"},
+		{"ascending", "## [1.0.0]\n## [1.1.0]\n", "line 2: release 1.1.0 is listed after the lower release 1.0.0"},
+		{"duplicate", "## [1.0.0]\n## [1.0.0+build]\n", "line 2: duplicate release 1.0.0+build"},
+		{"late unreleased", "## [1.0.0]\n## [Unreleased]\n", "line 2: Unreleased must be listed once"},
+		{"text in section", "## [1.0.0]\n### Added\n\nsome text\n", `line 4: expected a list item in section "Added"`},
+		{"text after links", "## [1.0.0]\n[1.0.0]: https://example.com\nmore\n", "line 3: text after the link definitions"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := changelog.Parse(tc.text)
+			if err == nil || !strings.Contains(err.Error(), tc.error) {
+				t.Errorf("Expected error containing %q, actual %v", tc.error, err)
+			}
+		})
+	}
+}
+
+// Test for NewRelease, Insert and Promote
+func TestInsert(t *testing.T) {
+	c, err := changelog.Parse(sample)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	date := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	r := changelog.NewRelease(semver.MustParse("1.0.1"), date, []changelog.Entry{
+		{changelog.Fixed, "Crash on empty input."},
+		{"Internal", "Faster tests."},
+		{changelog.Added, "The -json flag."},
+		{changelog.Fixed, "Typo in the help."},
+	})
+	if err := c.Insert(r); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := c.Validate(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if c.Releases[1].Version.String() != "1.0.1" {
+		t.Errorf("Expected 1.0.1 between 1.1.0 and 1.0.0, actual %v", c.Releases[1])
+	}
+	expected := "## [1.0.1] - 2025-02-01\n\n### Added\n\n- The -json flag.\n\n### Fixed\n\n- Crash on empty input.\n- Typo in the help.\n\n### Internal\n\n- Faster tests.\n\n## [1.0.0]"
+	if actual := c.Render(); !strings.Contains(actual, expected) {
+		t.Errorf("Expected the rendered changelog to contain\n%s\nactual\n%s", expected, actual)
+	}
+
+	if err := c.Insert(changelog.Release{Version: semver.MustParse("1.1.0")}); err == nil {
+		t.Errorf("Expected an error inserting a duplicate release")
+	}
+	if err := c.Insert(changelog.Release{Unreleased: true}); err == nil {
+		t.Errorf("Expected an error inserting a second Unreleased")
+	}
+
+	// the Unreleased changes become 1.1.1 and the Unreleased heading stays, empty
+	if err := c.Promote(semver.MustParse("1.1.1"), date); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(c.Render(), "## [Unreleased]\n\n## [1.1.1] - 2025-02-01\n\n### Fixed\n\n- Sort versions") {
+		t.Errorf("Unexpected changelog after Promote:\n%s", c.Render())
+	}
+	if err := c.Promote(semver.MustParse("1.1.1"), date); err == nil {
+		t.Errorf("Expected an error promoting to an existing release")
+	}
+
+	// the round trip survives the changes
+	again, err := changelog.Parse(c.Render())
+	if err != nil || again.Render() != c.Render() {
+		t.Errorf("Expected the rendered changelog to parse to itself, error %v", err)
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/maloquacious/semver"
+)
+
+// versionJSON is the output of parse.
+type versionJSON struct {
+	Version    string   `json:"version"`
+	Major      int      `json:"major"`
+	Minor      int      `json:"minor"`
+	Patch      int      `json:"patch"`
+	PreRelease []string `json:"prerelease"`
+	Build      []string `json:"build"`
+}
+
+// identifierStrings converts a list of identifiers, returning an empty
+// slice rather than nil so that it is written as [] in JSON.
+func identifierStrings(ids []semver.Identifier) []string {
+	list := make([]string, len(ids))
+	for i, id := range ids {
+		list[i] = id.String()
+	}
+	return list
+}
+
+// runParse prints the components of each version as JSON.
+func runParse(c *cli, args []string) int {
+	fs := c.flagSet()
+	if err := fs.Parse(args); err != nil {
+		return flagStatus(err)
+	}
+	versions, err := c.readVersions(fs.Args())
+	if err != nil {
+		return c.fail(err)
+	}
+	for _, v := range versions {
+		c.writeJSON(versionJSON{
+			Version:    v.String(),
+			Major:      v.Major,
+			Minor:      v.Minor,
+			Patch:      v.Patch,
+			PreRelease: identifierStrings(v.PreReleaseIdentifiers()),
+			Build:      identifierStrings(v.BuildIdentifiers()),
+		})
+	}
+	return exitOK
+}
+
+// runValidate checks every version, reporting each one that is invalid.
+func runValidate(c *cli, args []string) int {
+	fs := c.flagSet()
+	if err := fs.Parse(args); err != nil {
+		return flagStatus(err)
+	}
+	inputs, lines, err := c.inputs(fs.Args())
+	if err != nil {
+		return c.fail(err)
+	}
+	status := exitOK
+	for i, s := range inputs {
+		_, err := parseInput(s, lines[i])
+		if c.json {
+			result := struct {
+				Version string     `json:"version"`
+				Valid   bool       `json:"valid"`
+				Error   *errorJSON `json:"error,omitempty"`
+			}{Version: s, Valid: err == nil}
+			if err != nil {
+				result.Error = newErrorJSON(err)
+			}
+			c.writeJSON(result)
+		} else if err != nil {
+			c.fail(err)
+		}
+		if err != nil {
+			status = exitError
+		}
+	}
+	return status
+}
+
+// runCompare prints the result of comparing two versions and exits with it.
+func runCompare(c *cli, args []string) int {
+	fs := c.flagSet()
+	if err := fs.Parse(args); err != nil {
+		return flagStatus(err)
+	}
+	if fs.NArg() != 2 {
+		return c.usageError(fs, "expected two versions")
+	}
+	versions, err := c.readVersions(fs.Args())
+	if err != nil {
+		return c.fail(err)
+	}
+	cmp := versions[0].Compare(versions[1])
+	if c.json {
+		c.writeJSON(struct {
+			V1     semver.Version `json:"v1"`
+			V2     semver.Version `json:"v2"`
+			Result int            `json:"result"`
+		}{versions[0], versions[1], cmp})
+	} else {
+		fmt.Fprintln(c.stdout, cmp)
+	}
+	switch cmp {
+	case -1:
+		return exitLess
+	case 1:
+		return exitFalse
+	}
+	return exitOK
+}
+
+// runSort prints the versions in order of precedence. The sort is stable,
+// so versions that differ only in build metadata keep their input order.
+func runSort(c *cli, args []string) int {
+	fs := c.flagSet()
+	reverse := fs.Bool("reverse", false, "sort from highest to lowest")
+	unique := fs.Bool("unique", false, "drop repeated versions, including their build metadata")
+	if err := fs.Parse(args); err != nil {
+		return flagStatus(err)
+	}
+	versions, err := c.readVersions(fs.Args())
+	if err != nil {
+		return c.fail(err)
+	}
+	sort.Stable(semver.ByVersion(versions))
+	if *reverse {
+		slices.Reverse(versions)
+	}
+	if *unique {
+		seen := make(map[string]bool)
+		kept := versions[:0]
+		for _, v := range versions {
+			if s := v.String(); !seen[s] {
+				seen[s] = true
+				kept = append(kept, v)
+			}
+		}
+		versions = kept
+	}
+	if c.json {
+		c.writeJSON(struct {
+			Versions []semver.Version `json:"versions"`
+		}{versions})
+		return exitOK
+	}
+	for _, v := range versions {
+		fmt.Fprintln(c.stdout, v)
+	}
+	return exitOK
+}
+
+// runBump prints the version that follows the given one.
+func runBump(c *cli, args []string) int {
+	fs := c.flagSet()
+	var opts semver.BumpOptions
+	fs.StringVar(&opts.PreReleaseID, "preid", "", "identifier that leads new pre-releases, such as rc")
+	fs.BoolVar(&opts.KeepBuild, "keep-build", false, "keep the build metadata")
+	if err := fs.Parse(args); err != nil {
+		return flagStatus(err)
+	}
+	if fs.NArg() != 2 {
+		return c.usageError(fs, "expected a kind and a version")
+	}
+	kind, err := semver.ParseBumpKind(fs.Arg(0))
+	if err != nil {
+		return c.fail(err)
+	}
+	v, err := semver.Parse(fs.Arg(1))
+	if err != nil {
+		return c.fail(err)
+	}
+	next, err := v.Bump(kind, opts)
+	if err != nil {
+		return c.fail(err)
+	}
+	if c.json {
+		c.writeJSON(struct {
+			Version semver.Version `json:"version"`
+			Kind    string         `json:"kind"`
+			Result  semver.Version `json:"result"`
+		}{v, kind.String(), next})
+	} else {
+		fmt.Fprintln(c.stdout, next)
+	}
+	return exitOK
+}
+
+// constraintFlags defines the flags shared by the commands that check a constraint.
+func constraintFlags(fs *flag.FlagSet) *bool {
+	return fs.Bool("include-prerelease", false, "match pre-releases purely by precedence")
+}
+
+// parseConstraint parses the constraint given to a command.
+func parseConstraint(s string, includePreRelease bool) (semver.Constraint, error) {
+	constraint, err := semver.ParseConstraint(s)
+	constraint.IncludePreRelease = includePreRelease
+	return constraint, err
+}
+
+// runSatisfies prints the versions that satisfy a constraint.
+func runSatisfies(c *cli, args []string) int {
+	fs := c.flagSet()
+	includePreRelease := constraintFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return flagStatus(err)
+	}
+	if fs.NArg() == 0 {
+		return c.usageError(fs, "expected a constraint")
+	}
+	constraint, err := parseConstraint(fs.Arg(0), *includePreRelease)
+	if err != nil {
+		return c.fail(err)
+	}
+	versions, err := c.readVersions(fs.Args()[1:])
+	if err != nil {
+		return c.fail(err)
+	}
+	status := exitOK
+	for _, v := range versions {
+		ok := constraint.Check(v)
+		if c.json {
+			c.writeJSON(struct {
+				Version    semver.Version `json:"version"`
+				Constraint string         `json:"constraint"`
+				Satisfies  bool           `json:"satisfies"`
+			}{v, fs.Arg(0), ok})
+		} else if ok {
+			fmt.Fprintln(c.stdout, v)
+		}
+		if !ok {
+			status = exitFalse
+		}
+	}
+	return status
+}
+
+// runMax prints the highest version.
+func runMax(c *cli, args []string) int {
+	return runExtreme(c, args, 1)
+}
+
+// runMin prints the lowest version.
+func runMin(c *cli, args []string) int {
+	return runExtreme(c, args, -1)
+}
+
+// runExtreme prints the version that compares as want against every other
+// version satisfying the constraint. Of versions with the same precedence,
+// the first one wins.
+func runExtreme(c *cli, args []string, want int) int {
+	fs := c.flagSet()
+	source := fs.String("c", "", "only consider versions that satisfy this constraint")
+	includePreRelease := constraintFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return flagStatus(err)
+	}
+	var constraint *semver.Constraint
+	if *source != "" {
+		parsed, err := parseConstraint(*source, *includePreRelease)
+		if err != nil {
+			return c.fail(err)
+		}
+		constraint = &parsed
+	}
+	versions, err := c.readVersions(fs.Args())
+	if err != nil {
+		return c.fail(err)
+	}
+	var best *semver.Version
+	for i, v := range versions {
+		if constraint != nil && !constraint.Check(v) {
+			continue
+		}
+		if best == nil || v.Compare(*best) == want {
+			best = &versions[i]
+		}
+	}
+	if c.json {
+		c.writeJSON(struct {
+			Result *semver.Version `json:"result"`
+		}{best})
+	} else if best != nil {
+		fmt.Fprintln(c.stdout, best)
+	}
+	if best == nil {
+		return exitFalse
+	}
+	return exitOK
+}
+
+// runFormat prints each version in the given style.
+func runFormat(c *cli, args []string) int {
+	fs := c.flagSet()
+	style := fs.String("style", "string", "string (everything), short (no build metadata) or core (major.minor.patch)")
+	if err := fs.Parse(args); err != nil {
+		return flagStatus(err)
+	}
+	var format func(semver.Version) string
+	switch *style {
+	case "string":
+		format = semver.Version.String
+	case "short":
+		format = semver.Version.Short
+	case "core":
+		format = semver.Version.Core
+	default:
+		return c.usageError(fs, fmt.Sprintf("unknown style %q", *style))
+	}
+	versions, err := c.readVersions(fs.Args())
+	if err != nil {
+		return c.fail(err)
+	}
+	for _, v := range versions {
+		if c.json {
+			c.writeJSON(struct {
+				Version semver.Version `json:"version"`
+				Result  string         `json:"result"`
+			}{v, format(v)})
+		} else {
+			fmt.Fprintln(c.stdout, format(v))
+		}
+	}
+	return exitOK
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+// Command semver parses, compares, sorts and bumps semantic versions
+// for shell scripts, using the rules of the semver package.
+//
+// Usage:
+//
+//	semver <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	parse <version>...                  print the components of each version as JSON
+//	validate <version>...               check that each version is valid
+//	compare <v1> <v2>                   print -1, 0 or 1 as v1 is lower, equal or higher than v2
+//	sort [-reverse] [-unique] [<version>...]
+//	                                    print the versions in order of precedence
+//	bump [-preid id] [-keep-build] <kind> <version>
+//	                                    print the next version; kind is major, minor, patch,
+//	                                    premajor, preminor, prepatch or prerelease
+//	satisfies <constraint> [<version>...]
+//	                                    print the versions that satisfy the constraint
+//	max [-c constraint] [<version>...]  print the highest version
+//	min [-c constraint] [<version>...]  print the lowest version
+//	format [-style string|short|core] [<version>...]
+//	                                    print each version in the given style
+//
+// Commands that take a list of versions read them from standard input, one
+// per line, when there are none on the command line. Every command accepts
+// -json, which writes one JSON object per line instead of plain text;
+// parse always writes JSON.
+//
+// The exit status is 0 on success and 2 for invalid input or usage. Errors
+// give the position of the problem in the version or constraint, and in
+// JSON mode are written to standard output as {"error": ...} objects.
+// compare exits with 0, 1 or 255 (-1) to match its result, satisfies
+// exits with 1 when some version does not satisfy the constraint, and max
+// and min exit with 1 when no version satisfies it.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/maloquacious/semver"
+)
+
+// exit statuses
+const (
+	exitOK    = 0
+	exitFalse = 1   // a comparison or match came out false
+	exitError = 2   // invalid input or usage
+	exitLess  = 255 // compare's -1
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// command is a subcommand of the tool.
+type command struct {
+	name  string
+	usage string
+	run   func(c *cli, args []string) int
+}
+
+var commands = []command{
+	{"parse", "parse [-json] <version>...", runParse},
+	{"validate", "validate [-json] <version>...", runValidate},
+	{"compare", "compare [-json] <v1> <v2>", runCompare},
+	{"sort", "sort [-json] [-reverse] [-unique] [<version>...]", runSort},
+	{"bump", "bump [-json] [-preid id] [-keep-build] <kind> <version>", runBump},
+	{"satisfies", "satisfies [-json] <constraint> [<version>...]", runSatisfies},
+	{"max", "max [-json] [-c constraint] [<version>...]", runMax},
+	{"min", "min [-json] [-c constraint] [<version>...]", runMin},
+	{"format", "format [-json] [-style string|short|core] [<version>...]", runFormat},
+}
+
+// cli holds the streams and options of one run of the tool.
+type cli struct {
+	stdin          io.Reader
+	stdout, stderr io.Writer
+	cmd            command
+	json           bool
+}
+
+// run runs the tool with the given arguments and returns the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	c := &cli{stdin: stdin, stdout: stdout, stderr: stderr}
+	if len(args) == 0 {
+		c.usage()
+		return exitError
+	}
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			c.cmd = cmd
+			return cmd.run(c, args[1:])
+		}
+	}
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		c.usage()
+		return exitOK
+	}
+	fmt.Fprintf(stderr, "semver: unknown command %q\n", args[0])
+	c.usage()
+	return exitError
+}
+
+// flagSet returns the flag set for the current command, with the -json flag defined.
+func (c *cli) flagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("semver "+c.cmd.name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(c.stderr, "usage: semver %s\n", c.cmd.usage)
+		fs.PrintDefaults()
+	}
+	fs.BoolVar(&c.json, "json", false, "write JSON instead of plain text")
+	return fs
+}
+
+// flagStatus returns the exit status for an error from parsing the flags:
+// success for -h, which has printed the usage, and an error otherwise.
+func flagStatus(err error) int {
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	return exitError
+}
+
+// usage prints the list of commands.
+func (c *cli) usage() {
+	fmt.Fprintln(c.stderr, "usage: semver <command> [flags] [arguments]")
+	fmt.Fprintln(c.stderr, "\ncommands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(c.stderr, "  semver %s\n", cmd.usage)
+	}
+}
+
+// usageError reports a problem with the arguments of the current command.
+func (c *cli) usageError(fs *flag.FlagSet, msg string) int {
+	fmt.Fprintf(c.stderr, "%s: %s\n", fs.Name(), msg)
+	fs.Usage()
+	return exitError
+}
+
+// errorJSON is the JSON form of an error, with the position of the problem when it is known.
+type errorJSON struct {
+	Error     string `json:"error"`
+	Line      int    `json:"line,omitempty"`
+	Input     string `json:"input,omitempty"`
+	Offset    *int   `json:"offset,omitempty"`
+	Component string `json:"component,omitempty"`
+}
+
+// lineError is an invalid version read from standard input.
+type lineError struct {
+	line int
+	err  error
+}
+
+func (e *lineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.line, e.err)
+}
+
+func (e *lineError) Unwrap() error {
+	return e.err
+}
+
+// newErrorJSON describes err, pulling the position out of a *semver.ParseError
+// or *semver.ConstraintError.
+func newErrorJSON(err error) *errorJSON {
+	e := &errorJSON{Error: err.Error()}
+	var le *lineError
+	if errors.As(err, &le) {
+		e.Line = le.line
+	}
+	var pe *semver.ParseError
+	var ce *semver.ConstraintError
+	if errors.As(err, &ce) {
+		e.Input, e.Offset = ce.Input, &ce.Offset
+	} else if errors.As(err, &pe) {
+		e.Input, e.Offset, e.Component = pe.Input, &pe.Offset, pe.Component.String()
+	}
+	return e
+}
+
+// fail reports err and returns the error exit status. In JSON mode the
+// error is written to standard output so that it reaches the consumer.
+func (c *cli) fail(err error) int {
+	if c.json {
+		c.writeJSON(newErrorJSON(err))
+		return exitError
+	}
+	fmt.Fprintln(c.stderr, err)
+	if e := newErrorJSON(err); e.Offset != nil {
+		fmt.Fprintf(c.stderr, "\t%s\n\t%s^\n", e.Input, strings.Repeat(" ", *e.Offset))
+	}
+	return exitError
+}
+
+// writeJSON writes v as one line of JSON.
+func (c *cli) writeJSON(v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		// only invalid versions fail to marshal, and those are rejected earlier
+		panic(err)
+	}
+	fmt.Fprintf(c.stdout, "%s\n", data)
+}
+
+// inputs returns args, or the non-blank lines of standard input when args is empty.
+// The line numbers are returned so that errors can point at the bad line; they
+// are zero for arguments.
+func (c *cli) inputs(args []string) ([]string, []int, error) {
+	if len(args) != 0 {
+		return args, make([]int, len(args)), nil
+	}
+	var lines []string
+	var numbers []int
+	scanner := bufio.NewScanner(c.stdin)
+	for n := 1; scanner.Scan(); n++ {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines, numbers = append(lines, line), append(numbers, n)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("semver: reading standard input: %w", err)
+	}
+	return lines, numbers, nil
+}
+
+// parseInput parses a version, adding the line number of standard input to the error.
+func parseInput(s string, line int) (semver.Version, error) {
+	v, err := semver.Parse(s)
+	if err != nil && line != 0 {
+		return v, &lineError{line: line, err: err}
+	}
+	return v, err
+}
+
+// parseAll parses each input, stopping at the first invalid one.
+func parseAll(inputs []string, lines []int) ([]semver.Version, error) {
+	versions := make([]semver.Version, len(inputs))
+	for i, s := range inputs {
+		v, err := parseInput(s, lines[i])
+		if err != nil {
+			return nil, err
+		}
+		versions[i] = v
+	}
+	return versions, nil
+}
+
+// readVersions parses args, or standard input when args is empty.
+func (c *cli) readVersions(args []string) ([]semver.Version, error) {
+	inputs, lines, err := c.inputs(args)
+	if err != nil {
+		return nil, err
+	}
+	return parseAll(inputs, lines)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// Test for the commands, run through run with captured streams
+func TestRun(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		args   string
+		stdin  string
+		status int
+		stdout string
+		stderr string // a substring expected in stderr
+	}{
+		{desc: "no command", args: "", status: exitError, stderr: "usage: semver <command>"},
+		{desc: "unknown command", args: "bogus", status: exitError, stderr: `unknown command "bogus"`},
+		{desc: "help", args: "help", status: exitOK, stderr: "semver sort"},
+		{desc: "command help", args: "sort -h", status: exitOK, stderr: "-reverse"},
+
+		{desc: "parse", args: "parse 1.2.3-rc.1+sha.5114f85",
+			stdout: `{"version":"1.2.3-rc.1+sha.5114f85","major":1,"minor":2,"patch":3,"prerelease":["rc","1"],"build":["sha","5114f85"]}` + "\n"},
+		{desc: "parse release", args: "parse 10.0.0",
+			stdout: `{"version":"10.0.0","major":10,"minor":0,"patch":0,"prerelease":[],"build":[]}` + "\n"},
+		{desc: "parse out of range", args: "parse 99999999999999999999.0.0", status: exitError, stderr: "value out of range"},
+		{desc: "parse invalid", args: "parse 1.02.3", status: exitError,
+			stderr: "semver: invalid minor in \"1.02.3\" at offset 2: leading zero\n\t1.02.3\n\t  ^\n"},
+		{desc: "parse invalid json", args: "parse -json 1.02.3", status: exitError,
+			stdout: `{"error":"semver: invalid minor in \"1.02.3\" at offset 2: leading zero","input":"1.02.3","offset":2,"component":"minor"}` + "\n"},
+
+		{desc: "validate", args: "validate 1.2.3 1.0.0-rc.1"},
+		{desc: "validate invalid", args: "validate 1.2.3 1.2", status: exitError, stderr: "at offset 3"},
+		{desc: "validate json", args: "validate -json 1.2.3 v1", status: exitError,
+			stdout: `{"version":"1.2.3","valid":true}` + "\n" +
+				`{"version":"v1","valid":false,"error":{"error":"semver: invalid major in \"v1\" at offset 0: unexpected character 'v', expected digit","input":"v1","offset":0,"component":"major"}}` + "\n"},
+		{desc: "validate stdin", args: "validate", stdin: "1.2.3\n\n1.2.x\n", status: exitError, stderr: "line 3: semver: invalid patch"},
+
+		{desc: "compare less", args: "compare 1.0.0-rc.1 1.0.0", status: exitLess, stdout: "-1\n"},
+		{desc: "compare equal", args: "compare 1.0.0+a 1.0.0+b", status: exitOK, stdout: "0\n"},
+		{desc: "compare greater", args: "compare 1.10.0 1.9.0", status: exitFalse, stdout: "1\n"},
+		{desc: "compare json", args: "compare -json 1.10.0 1.9.0", status: exitFalse, stdout: `{"v1":"1.10.0","v2":"1.9.0","result":1}` + "\n"},
+		{desc: "compare one version", args: "compare 1.0.0", status: exitError, stderr: "expected two versions"},
+
+		{desc: "sort", args: "sort", stdin: "1.10.0\n1.2.0\n1.0.0-rc.1\n1.2.0\n", stdout: "1.0.0-rc.1\n1.2.0\n1.2.0\n1.10.0\n"},
+		{desc: "sort stable", args: "sort", stdin: "1.0.0+b\n0.9.0\n1.0.0+a\n", stdout: "0.9.0\n1.0.0+b\n1.0.0+a\n"},
+		{desc: "sort reverse unique", args: "sort -reverse -unique 1.2.0 1.10.0 1.2.0 1.2.0+x", stdout: "1.10.0\n1.2.0+x\n1.2.0\n"},
+		{desc: "sort json", args: "sort -json", stdin: "2.0.0\n1.0.0\n", stdout: `{"versions":["1.0.0","2.0.0"]}` + "\n"},
+		{desc: "sort empty json", args: "sort -json", stdout: `{"versions":[]}` + "\n"},
+		{desc: "sort invalid", args: "sort -json", stdin: "1.0.0\nbad\n", status: exitError,
+			stdout: `{"error":"line 2: semver: invalid major in \"bad\" at offset 0: unexpected character 'b', expected digit","line":2,"input":"bad","offset":0,"component":"major"}` + "\n"},
+
+		{desc: "bump", args: "bump minor 1.2.3-rc.1", stdout: "1.3.0\n"},
+		{desc: "bump preid", args: "bump -preid rc premajor 1.2.3", stdout: "2.0.0-rc.0\n"},
+		{desc: "bump keep build", args: "bump -keep-build -json patch 1.2.3+sha.1", stdout: `{"version":"1.2.3+sha.1","kind":"patch","result":"1.2.4+sha.1"}` + "\n"},
+		{desc: "bump unknown kind", args: "bump huge 1.2.3", status: exitError, stderr: `unknown bump kind "huge"`},
+		{desc: "bump missing version", args: "bump major", status: exitError, stderr: "expected a kind and a version"},
+
+		{desc: "satisfies", args: "satisfies ^1.2 1.2.0 1.9.9 2.0.0 1.3.0-rc.1", status: exitFalse, stdout: "1.2.0\n1.9.9\n"},
+		{desc: "satisfies no constraint", args: "satisfies", stdin: "1.2.0\n", status: exitError, stderr: "expected a constraint"},
+		{desc: "satisfies pre-release", args: "satisfies -include-prerelease ^1.2 1.3.0-rc.1", stdout: "1.3.0-rc.1\n"},
+		{desc: "satisfies json", args: "satisfies -json ~1.2 1.2.5 1.3.0", status: exitFalse,
+			stdout: `{"version":"1.2.5","constraint":"~1.2","satisfies":true}` + "\n" + `{"version":"1.3.0","constraint":"~1.2","satisfies":false}` + "\n"},
+		{desc: "satisfies invalid constraint", args: "satisfies >=1.2.3.4 1.2.3", status: exitError, stderr: "\t>=1.2.3.4\n\t       ^\n"},
+
+		{desc: "max", args: "max 1.2.0 1.10.0 1.9.0", stdout: "1.10.0\n"},
+		{desc: "max constraint", args: "max -c <1.10 1.2.0 1.10.0 1.9.0", stdout: "1.9.0\n"},
+		{desc: "max none", args: "max -json -c >2 1.2.0", status: exitFalse, stdout: `{"result":null}` + "\n"},
+		{desc: "min", args: "min", stdin: "1.2.0\n1.0.0-rc.1\n1.0.0\n", stdout: "1.0.0-rc.1\n"},
+		{desc: "min json", args: "min -json 1.2.0 1.1.0", stdout: `{"result":"1.1.0"}` + "\n"},
+
+		{desc: "format", args: "format 1.2.3-rc.1+sha.1", stdout: "1.2.3-rc.1+sha.1\n"},
+		{desc: "format short", args: "format -style short 1.2.3-rc.1+sha.1", stdout: "1.2.3-rc.1\n"},
+		{desc: "format core json", args: "format -style core -json 1.2.3-rc.1+sha.1", stdout: `{"version":"1.2.3-rc.1+sha.1","result":"1.2.3"}` + "\n"},
+		{desc: "format unknown style", args: "format -style long 1.2.3", status: exitError, stderr: `unknown style "long"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			status := run(strings.Fields(tc.args), strings.NewReader(tc.stdin), &stdout, &stderr)
+			if status != tc.status {
+				t.Errorf("Expected status %d, actual %d (stderr %q)", tc.status, status, stderr.String())
+			}
+			if stdout.String() != tc.stdout {
+				t.Errorf("Expected stdout %q, actual %q", tc.stdout, stdout.String())
+			}
+			if !strings.Contains(stderr.String(), tc.stderr) {
+				t.Errorf("Expected stderr to contain %q, actual %q", tc.stderr, stderr.String())
+			}
+		})
+	}
+}
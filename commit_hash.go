@@ -3,41 +3,137 @@
 package semver
 
 import (
+	"fmt"
 	"runtime/debug"
+	"strings"
+	"time"
 )
 
+// BuildInfo is the build information embedded in the running binary,
+// with the version control settings decoded into fields.
+type BuildInfo struct {
+	Revision      string               // full VCS revision (vcs.revision), such as a Git commit hash
+	ShortRevision string               // first 7 characters of Revision, matching Git's short format
+	Time          time.Time            // commit time of Revision (vcs.time), zero if unknown
+	Modified      bool                 // the working directory had uncommitted changes (vcs.modified)
+	VCS           string               // version control system (vcs), such as "git"
+	GoVersion     string               // version of the Go toolchain that built the binary
+	MainModule    debug.Module         // the main module; its Version is "(devel)" for local builds
+	Deps          []debug.Module       // the module dependencies, with replacements in Replace
+	Settings      []debug.BuildSetting // every build setting, including the vcs settings above
+}
+
+// ReadBuildInfo returns the build information from DefaultBuildInfoSource,
+// which is the running binary unless replaced.
+// The boolean is false when the binary was built without module support.
+// Test binaries and "go run" builds have build information but usually no vcs settings.
+func ReadBuildInfo() (BuildInfo, bool) {
+	return ReadBuildInfoFrom(DefaultBuildInfoSource)
+}
+
+// newBuildInfo decodes the settings of a *debug.BuildInfo.
+func newBuildInfo(info *debug.BuildInfo) BuildInfo {
+	b := BuildInfo{
+		GoVersion:  info.GoVersion,
+		MainModule: info.Main,
+		Settings:   append([]debug.BuildSetting(nil), info.Settings...),
+	}
+	for _, dep := range info.Deps {
+		if dep != nil {
+			b.Deps = append(b.Deps, *dep)
+		}
+	}
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs":
+			b.VCS = setting.Value
+		case "vcs.modified":
+			b.Modified = setting.Value == "true"
+		case "vcs.revision":
+			b.Revision = setting.Value
+			b.ShortRevision = setting.Value
+			if len(b.ShortRevision) > 7 {
+				b.ShortRevision = b.ShortRevision[:7]
+			}
+		case "vcs.time":
+			if t, err := time.Parse(time.RFC3339, setting.Value); err == nil {
+				b.Time = t
+			}
+		}
+	}
+	return b
+}
+
+// Commit returns the short revision, with a "-dirty" suffix if the working
+// directory was modified, in a form that is valid as build metadata.
+//
+// Examples:
+//   - "abc1234" for a clean build of commit abc1234
+//   - "abc1234-dirty" for a build with uncommitted changes
+//   - "dirty" for a modified build with no revision, such as after "git init" and before the first commit
+//   - "" when there is no VCS information
+func (b BuildInfo) Commit() string {
+	if b.ShortRevision != "" && b.Modified {
+		return b.ShortRevision + "-dirty"
+	} else if b.Modified {
+		return "dirty"
+	}
+	return b.ShortRevision
+}
+
 // Commit returns the VCS commit information for the current build.
 // Returns the first 7 characters of the commit hash (matching Git's short format)
 // with "-dirty" suffix if the working directory has uncommitted changes.
 // Returns "dirty" if no commit hash is available but working directory is dirty.
 // Returns empty string if no VCS information is available.
+// See BuildInfo for the full revision and the rest of the build information,
+// and DefaultBuildInfoSource for replacing the build information in tests.
 func Commit() string {
-	var commitHash string
-	var dirtyWorkingDirectory bool
-
-	if info, ok := debug.ReadBuildInfo(); ok {
-		for _, setting := range info.Settings {
-			switch setting.Key {
-			case "vcs.modified":
-				dirtyWorkingDirectory = setting.Value == "true"
-			case "vcs.revision":
-				if len(setting.Value) > 7 {
-					commitHash = setting.Value[:7]
-				} else {
-					commitHash = setting.Value
-				}
-			}
+	info, _ := ReadBuildInfo()
+	return info.Commit()
+}
+
+// Version returns the version of the main module. A module built with
+// "go install module@v1.4.2" reports 1.4.2; a local build reports "(devel)",
+// for which Version synthesizes a pre-release from the vcs settings:
+// 0.0.0-devel.<time>+<revision>, where time is the commit time as
+// YYYYMMDDhhmmss in UTC and revision is ShortRevision followed by
+// ".dirty" if the working directory was modified. Parts that are not
+// known are left out, so a build without vcs settings is 0.0.0-devel.
+//
+// It returns an error if the main module version is not a valid semantic
+// version after removing the leading "v".
+func (b BuildInfo) Version() (Version, error) {
+	if mv := b.MainModule.Version; mv != "" && mv != "(devel)" {
+		v, err := Parse(strings.TrimPrefix(mv, "v"))
+		if err != nil {
+			return Version{}, fmt.Errorf("semver: main module version %q: %w", mv, err)
 		}
+		return v, nil
 	}
 
-	if commitHash != "" && dirtyWorkingDirectory {
-		if dirtyWorkingDirectory {
-			return commitHash + "-dirty"
-		}
-		return commitHash
-	} else if dirtyWorkingDirectory {
-		// edge case after "git init" and before the first commit
-		return "dirty"
+	v := Version{PreRelease: "devel"}
+	if !b.Time.IsZero() {
+		v.PreRelease += "." + b.Time.UTC().Format("20060102150405")
+	}
+	var build []string
+	if b.ShortRevision != "" {
+		build = append(build, b.ShortRevision)
+	}
+	if b.Modified {
+		build = append(build, "dirty")
+	}
+	v.Build = strings.Join(build, ".")
+	return v, nil
+}
+
+// FromBuildInfo returns the version of the main module from the build
+// information in DefaultBuildInfoSource, as described by BuildInfo.Version.
+// It returns an error if there is no build information.
+func FromBuildInfo() (Version, error) {
+	info, ok := ReadBuildInfo()
+	if !ok {
+		return Version{}, fmt.Errorf("semver: no build information")
 	}
-	return ""
+	return info.Version()
 }
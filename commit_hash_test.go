@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"runtime"
+	"runtime/debug"
+	"testing"
+	"time"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for BuildInfo.Commit
+func TestBuildInfoCommit(t *testing.T) {
+	testCases := []struct {
+		name     string
+		info     semver.BuildInfo
+		expected string
+	}{
+		{"clean", semver.BuildInfo{Revision: "abc1234def5678", ShortRevision: "abc1234"}, "abc1234"},
+		{"dirty", semver.BuildInfo{Revision: "abc1234def5678", ShortRevision: "abc1234", Modified: true}, "abc1234-dirty"},
+		{"dirty without revision", semver.BuildInfo{Modified: true}, "dirty"},
+		{"no vcs", semver.BuildInfo{}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.info.Commit(); actual != tc.expected {
+				t.Errorf("Unexpected commit. expected: %q, actual: %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+// Test for ReadBuildInfo in a test binary
+func TestReadBuildInfo(t *testing.T) {
+	info, ok := semver.ReadBuildInfo()
+	if !ok {
+		t.Skip("binary built without module support")
+	}
+	if info.GoVersion != runtime.Version() {
+		t.Errorf("Unexpected GoVersion. expected: %q, actual: %q", runtime.Version(), info.GoVersion)
+	}
+	if actual := semver.Commit(); actual != info.Commit() {
+		t.Errorf("Commit() %q does not match BuildInfo.Commit() %q", actual, info.Commit())
+	}
+}
+
+// Test for BuildInfo.Version
+func TestBuildInfoVersion(t *testing.T) {
+	commitTime := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		info     semver.BuildInfo
+		expected string
+	}{
+		{"installed", semver.BuildInfo{MainModule: debug.Module{Version: "v1.4.2"}, ShortRevision: "5114f85"}, "1.4.2"},
+		{"pseudo-version", semver.BuildInfo{MainModule: debug.Module{Version: "v0.0.0-20250314150926-5114f85c1d6a"}}, "0.0.0-20250314150926-5114f85c1d6a"},
+		{"incompatible", semver.BuildInfo{MainModule: debug.Module{Version: "v2.0.1+incompatible"}}, "2.0.1+incompatible"},
+		{"devel", semver.BuildInfo{MainModule: debug.Module{Version: "(devel)"}, ShortRevision: "5114f85", Time: commitTime}, "0.0.0-devel.20250314150926+5114f85"},
+		{"devel dirty", semver.BuildInfo{MainModule: debug.Module{Version: "(devel)"}, ShortRevision: "5114f85", Time: commitTime.In(time.FixedZone("EST", -5*3600)), Modified: true}, "0.0.0-devel.20250314150926+5114f85.dirty"},
+		{"devel before first commit", semver.BuildInfo{MainModule: debug.Module{Version: "(devel)"}, Modified: true}, "0.0.0-devel+dirty"},
+		{"no vcs", semver.BuildInfo{}, "0.0.0-devel"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := tc.info.Version()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if v.String() != tc.expected {
+				t.Errorf("Unexpected version. expected: %q, actual: %q", tc.expected, v)
+			}
+			if err := v.Validate(); err != nil {
+				t.Errorf("Invalid version %q: %v", v, err)
+			}
+		})
+	}
+
+	if _, err := (semver.BuildInfo{MainModule: debug.Module{Version: "v1.4"}}).Version(); err == nil {
+		t.Errorf("Expected error for invalid main module version")
+	}
+}
+
+// Test for FromBuildInfo
+func TestFromBuildInfo(t *testing.T) {
+	info, err := semver.ParseBuildInfo(goVersionDump)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	useBuildInfo(t, semver.StaticBuildInfo{Info: info})
+	if v, err := semver.FromBuildInfo(); err != nil || v.String() != "1.4.2" {
+		t.Errorf("Expected 1.4.2, got %q (%v)", v, err)
+	}
+
+	info.Main.Version = "(devel)"
+	if v, err := semver.FromBuildInfo(); err != nil || v.String() != "0.0.0-devel.20250314150926+5114f85.dirty" {
+		t.Errorf("Expected 0.0.0-devel.20250314150926+5114f85.dirty, got %q (%v)", v, err)
+	}
+
+	useBuildInfo(t, semver.StaticBuildInfo{})
+	if _, err := semver.FromBuildInfo(); err == nil {
+		t.Errorf("Expected error without build information")
+	}
+}
@@ -0,0 +1,456 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Operator is the comparison operator of a Comparator.
+type Operator int
+
+const (
+	OpEqual        Operator = iota // "=" or no operator
+	OpLess                         // "<"
+	OpLessEqual                    // "<="
+	OpGreater                      // ">"
+	OpGreaterEqual                 // ">="
+)
+
+// String implements the fmt.Stringer interface.
+// OpEqual is returned as "=" even though Comparator.String omits it.
+func (op Operator) String() string {
+	switch op {
+	case OpEqual:
+		return "="
+	case OpLess:
+		return "<"
+	case OpLessEqual:
+		return "<="
+	case OpGreater:
+		return ">"
+	case OpGreaterEqual:
+		return ">="
+	}
+	return fmt.Sprintf("Operator(%d)", int(op))
+}
+
+// Comparator is a single primitive constraint such as ">=1.2.3".
+// Caret, tilde, X-ranges and hyphen ranges are all reduced to
+// comparators when a Constraint is parsed.
+type Comparator struct {
+	Op      Operator
+	Version Version
+}
+
+// String implements the fmt.Stringer interface.
+// An OpEqual comparator is printed as the bare version, as in node-semver.
+func (c Comparator) String() string {
+	if c.Op == OpEqual {
+		return c.Version.String()
+	}
+	return c.Op.String() + c.Version.String()
+}
+
+// Check reports whether v satisfies the comparator using Compare.
+// It does not apply the pre-release rule that Constraint.Check does.
+func (c Comparator) Check(v Version) bool {
+	cmp := v.Compare(c.Version)
+	switch c.Op {
+	case OpEqual:
+		return cmp == 0
+	case OpLess:
+		return cmp < 0
+	case OpLessEqual:
+		return cmp <= 0
+	case OpGreater:
+		return cmp > 0
+	case OpGreaterEqual:
+		return cmp >= 0
+	}
+	return false
+}
+
+// Constraint is a version range in the syntax used by npm and node-semver.
+// It is a list of comparator sets joined by "||"; a version satisfies the
+// constraint if it satisfies every comparator in at least one set.
+//
+// Supported syntax:
+//   - primitive comparators: "<1.2.3", "<=1.2.3", ">1.2.3", ">=1.2.3", "=1.2.3", "1.2.3"
+//   - X-ranges: "*", "1.x", "1.2.*", "1", "1.2"
+//   - tilde ranges: "~1.2.3" (>=1.2.3 <1.3.0-0), "~1.2", "~1"
+//   - caret ranges: "^1.2.3" (>=1.2.3 <2.0.0-0), "^0.2.3" (>=0.2.3 <0.3.0-0), "^0.0.3" (>=0.0.3 <0.0.4-0)
+//   - hyphen ranges: "1.2.3 - 2.3.4" (>=1.2.3 <=2.3.4), "1.2 - 2.3" (>=1.2.0 <2.4.0-0)
+//   - any of the above joined by spaces (AND) and "||" (OR)
+type Constraint struct {
+	// Sets holds the comparator sets, each the result of desugaring
+	// one "||" separated part of the input.
+	Sets [][]Comparator
+
+	// IncludePreRelease disables the node-semver pre-release rule.
+	// By default a pre-release version only satisfies a comparator set
+	// if some comparator in that set has a pre-release with the same
+	// major, minor and patch numbers. When IncludePreRelease is true,
+	// pre-release versions are matched purely by precedence.
+	// The comparators themselves are not changed, so ">1.2" still
+	// means ">=1.3.0" and does not admit 1.3.0-beta.
+	IncludePreRelease bool
+}
+
+// ConstraintError is returned by ParseConstraint when the input is not a valid constraint.
+type ConstraintError struct {
+	Input  string // the constraint that was being parsed
+	Offset int    // byte offset into Input where the error was detected
+	Msg    string // description of the problem
+	Err    error  // the underlying *ParseError for an invalid version, if any
+}
+
+// Error implements the error interface.
+func (e *ConstraintError) Error() string {
+	return fmt.Sprintf("semver: invalid constraint %q at offset %d: %s", e.Input, e.Offset, e.Msg)
+}
+
+// Unwrap returns the underlying error, if any.
+func (e *ConstraintError) Unwrap() error {
+	return e.Err
+}
+
+// ParseConstraint parses s as a node-semver style range.
+// On failure the returned error is a *ConstraintError.
+//
+// Examples:
+//   - ParseConstraint("^1.2.3 || >=2.1.0 <3") is ">=1.2.3 <2.0.0-0 || >=2.1.0 <3.0.0-0"
+//   - ParseConstraint("~1.2") is ">=1.2.0 <1.3.0-0"
+//   - ParseConstraint("1.2.3 - 2.3") is ">=1.2.3 <2.4.0-0"
+func ParseConstraint(s string) (Constraint, error) {
+	var c Constraint
+	start := 0
+	for {
+		end := strings.Index(s[start:], "||")
+		if end == -1 {
+			end = len(s)
+		} else {
+			end += start
+		}
+		set, err := parseComparatorSet(s, start, end)
+		if err != nil {
+			return Constraint{}, err
+		}
+		c.Sets = append(c.Sets, set)
+		if end == len(s) {
+			break
+		}
+		start = end + 2
+	}
+	return c, nil
+}
+
+// MustParseConstraint is like ParseConstraint but panics if s is not a valid constraint.
+func MustParseConstraint(s string) Constraint {
+	c, err := ParseConstraint(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Check reports whether v satisfies the constraint.
+func (c Constraint) Check(v Version) bool {
+	for _, set := range c.Sets {
+		if c.checkSet(set, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSet reports whether v satisfies every comparator in set,
+// applying the pre-release rule unless IncludePreRelease is set.
+func (c Constraint) checkSet(set []Comparator, v Version) bool {
+	for _, cmp := range set {
+		if !cmp.Check(v) {
+			return false
+		}
+	}
+	if v.PreRelease == "" || c.IncludePreRelease {
+		return true
+	}
+	return preReleaseAllowed(set, v)
+}
+
+// preReleaseAllowed reports whether some comparator in set has a pre-release
+// on the same major.minor.patch tuple as v, which is the condition under which
+// node-semver lets a pre-release version match.
+func preReleaseAllowed(set []Comparator, v Version) bool {
+	for _, cmp := range set {
+		if cmp.Version.PreRelease != "" && sameCore(cmp.Version, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameCore reports whether a and b have the same major, minor and patch numbers.
+func sameCore(a, b Version) bool {
+	return a.Major == b.Major && a.Minor == b.Minor && a.Patch == b.Patch
+}
+
+// String implements the fmt.Stringer interface.
+// It returns the desugared comparators, with sets joined by " || ".
+func (c Constraint) String() string {
+	sets := make([]string, len(c.Sets))
+	for i, set := range c.Sets {
+		sets[i] = formatSet(set)
+	}
+	return strings.Join(sets, " || ")
+}
+
+// constraintToken is a whitespace separated word of a comparator set
+// along with its byte offset in the constraint.
+type constraintToken struct {
+	text   string
+	offset int
+}
+
+// parseComparatorSet parses s[start:end], one "||" separated part of a constraint.
+func parseComparatorSet(s string, start, end int) ([]Comparator, error) {
+	var tokens []constraintToken
+	for pos := start; pos < end; {
+		if s[pos] == ' ' || s[pos] == '\t' {
+			pos++
+			continue
+		}
+		tok := constraintToken{offset: pos}
+		for pos < end && s[pos] != ' ' && s[pos] != '\t' {
+			pos++
+		}
+		tok.text = s[tok.offset:pos]
+		// join an operator that is separated from its version by white space
+		// keeping the offset of the version rather than the operator
+		if n := len(tokens); n > 0 && strings.Trim(tokens[n-1].text, "<>=~^") == "" {
+			tokens[n-1] = constraintToken{text: tokens[n-1].text + tok.text, offset: tok.offset - len(tokens[n-1].text)}
+			continue
+		}
+		tokens = append(tokens, tok)
+	}
+
+	if len(tokens) == 0 {
+		return []Comparator{anyComparator()}, nil
+	}
+
+	if len(tokens) == 3 && tokens[1].text == "-" {
+		from, err := parsePartial(s, tokens[0])
+		if err != nil {
+			return nil, err
+		}
+		to, err := parsePartial(s, tokens[2])
+		if err != nil {
+			return nil, err
+		}
+		return hyphenRange(from, to), nil
+	}
+
+	var set []Comparator
+	for _, tok := range tokens {
+		op := tok.text[:len(tok.text)-len(strings.TrimLeft(tok.text, "<>=~^"))]
+		tok.text, tok.offset = tok.text[len(op):], tok.offset+len(op)
+		if op == "~>" {
+			op = "~"
+		}
+		if tok.text == "" {
+			return nil, &ConstraintError{Input: s, Offset: tok.offset, Msg: fmt.Sprintf("missing version after %q", op)}
+		}
+		p, err := parsePartial(s, tok)
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case "^":
+			set = append(set, caretRange(p)...)
+		case "~":
+			set = append(set, tildeRange(p)...)
+		case "", "=", "<", "<=", ">", ">=":
+			set = append(set, xRange(op, p)...)
+		default:
+			return nil, &ConstraintError{Input: s, Offset: tok.offset - len(op), Msg: fmt.Sprintf("unknown operator %q", op)}
+		}
+	}
+	return set, nil
+}
+
+// partial is a possibly incomplete version from a constraint, such as "1.2" or "1.x".
+type partial struct {
+	major, minor, patch int
+	n                   int // number of numeric components given; the rest are wildcards
+	pre                 string
+}
+
+// version returns the partial as a Version with missing components set to 0.
+func (p partial) version() Version {
+	return Version{Major: p.major, Minor: p.minor, Patch: p.patch, PreRelease: p.pre}
+}
+
+// parsePartial parses the version of a constraint token.
+// Leading "v" and "=" characters are ignored, and any of the
+// major, minor or patch numbers may be replaced by "x", "X" or "*".
+// Build metadata is accepted and ignored.
+func parsePartial(s string, tok constraintToken) (partial, error) {
+	var p partial
+	text := strings.TrimLeft(tok.text, "v=")
+	offset := tok.offset + len(tok.text) - len(text)
+
+	core := text
+	if i := strings.IndexAny(text, "-+"); i != -1 {
+		core = text[:i]
+	}
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 {
+		return partial{}, &ConstraintError{Input: s, Offset: offset + len(strings.Join(parts[:3], ".")), Msg: "too many version components"}
+	}
+	numbers := []*int{&p.major, &p.minor, &p.patch}
+	wild := false
+	pos := offset
+	for i, part := range parts {
+		switch {
+		case part == "x" || part == "X" || part == "*":
+			wild = true
+		case wild:
+			return partial{}, &ConstraintError{Input: s, Offset: pos, Msg: "version number after wildcard"}
+		case part == "":
+			return partial{}, &ConstraintError{Input: s, Offset: pos, Msg: "empty version component"}
+		default:
+			n, end, err := parseNumber(part, 0, Component(i))
+			if err != nil {
+				perr := err.(*ParseError)
+				return partial{}, &ConstraintError{Input: s, Offset: pos + perr.Offset, Msg: perr.Msg, Err: err}
+			} else if end != len(part) {
+				return partial{}, &ConstraintError{Input: s, Offset: pos + end, Msg: fmt.Sprintf("unexpected character %q", part[end])}
+			}
+			*numbers[i], p.n = n, i+1
+		}
+		pos += len(part) + 1
+	}
+
+	if len(core) < len(text) {
+		if p.n < 3 {
+			return partial{}, &ConstraintError{Input: s, Offset: offset + len(core), Msg: "pre-release or build requires a full version"}
+		}
+		v, err := Parse(text)
+		if err != nil {
+			perr := err.(*ParseError)
+			return partial{}, &ConstraintError{Input: s, Offset: offset + perr.Offset, Msg: perr.Msg, Err: err}
+		}
+		p.pre = v.PreRelease
+	}
+	return p, nil
+}
+
+// anyComparator returns a comparator that every release satisfies.
+func anyComparator() Comparator {
+	return Comparator{Op: OpGreaterEqual, Version: Version{}}
+}
+
+// firstPreRelease returns "MAJOR.MINOR.PATCH-0", the lowest version with
+// those numbers. node-semver uses it as an exclusive upper bound to keep
+// the pre-releases of the next version out of a range.
+func firstPreRelease(major, minor, patch int) Version {
+	return Version{Major: major, Minor: minor, Patch: patch, PreRelease: "0"}
+}
+
+// xRange desugars a primitive comparator or X-range.
+func xRange(op string, p partial) []Comparator {
+	if op == "=" {
+		op = ""
+	}
+	if p.n == 0 {
+		if op == "<" || op == ">" {
+			// nothing is less than or greater than every version
+			return []Comparator{{Op: OpLess, Version: firstPreRelease(0, 0, 0)}}
+		}
+		return []Comparator{anyComparator()}
+	}
+	if p.n == 3 {
+		switch op {
+		case "<":
+			return []Comparator{{Op: OpLess, Version: p.version()}}
+		case "<=":
+			return []Comparator{{Op: OpLessEqual, Version: p.version()}}
+		case ">":
+			return []Comparator{{Op: OpGreater, Version: p.version()}}
+		case ">=":
+			return []Comparator{{Op: OpGreaterEqual, Version: p.version()}}
+		}
+		return []Comparator{{Op: OpEqual, Version: p.version()}}
+	}
+	switch op {
+	case "":
+		lower := Version{Major: p.major, Minor: p.minor}
+		if p.n == 1 {
+			return []Comparator{{Op: OpGreaterEqual, Version: lower}, {Op: OpLess, Version: firstPreRelease(p.major+1, 0, 0)}}
+		}
+		return []Comparator{{Op: OpGreaterEqual, Version: lower}, {Op: OpLess, Version: firstPreRelease(p.major, p.minor+1, 0)}}
+	case ">":
+		if p.n == 1 {
+			return []Comparator{{Op: OpGreaterEqual, Version: Version{Major: p.major + 1}}}
+		}
+		return []Comparator{{Op: OpGreaterEqual, Version: Version{Major: p.major, Minor: p.minor + 1}}}
+	case ">=":
+		return []Comparator{{Op: OpGreaterEqual, Version: Version{Major: p.major, Minor: p.minor}}}
+	case "<":
+		return []Comparator{{Op: OpLess, Version: firstPreRelease(p.major, p.minor, 0)}}
+	}
+	// "<="
+	if p.n == 1 {
+		return []Comparator{{Op: OpLess, Version: firstPreRelease(p.major+1, 0, 0)}}
+	}
+	return []Comparator{{Op: OpLess, Version: firstPreRelease(p.major, p.minor+1, 0)}}
+}
+
+// tildeRange desugars "~" ranges, which allow patch-level changes
+// when a minor version is given and minor-level changes if not.
+func tildeRange(p partial) []Comparator {
+	switch p.n {
+	case 0:
+		return []Comparator{anyComparator()}
+	case 1:
+		return []Comparator{{Op: OpGreaterEqual, Version: Version{Major: p.major}}, {Op: OpLess, Version: firstPreRelease(p.major+1, 0, 0)}}
+	}
+	return []Comparator{{Op: OpGreaterEqual, Version: p.version()}, {Op: OpLess, Version: firstPreRelease(p.major, p.minor+1, 0)}}
+}
+
+// caretRange desugars "^" ranges, which allow changes that do not modify
+// the left-most non-zero component.
+func caretRange(p partial) []Comparator {
+	lower := Comparator{Op: OpGreaterEqual, Version: p.version()}
+	switch {
+	case p.n == 0:
+		return []Comparator{anyComparator()}
+	case p.n == 1 || p.major != 0:
+		return []Comparator{lower, {Op: OpLess, Version: firstPreRelease(p.major+1, 0, 0)}}
+	case p.n == 2 || p.minor != 0:
+		return []Comparator{lower, {Op: OpLess, Version: firstPreRelease(0, p.minor+1, 0)}}
+	}
+	return []Comparator{lower, {Op: OpLess, Version: firstPreRelease(0, 0, p.patch+1)}}
+}
+
+// hyphenRange desugars "from - to" ranges. A partial lower bound is filled
+// with zeros, and a partial upper bound excludes the next version.
+func hyphenRange(from, to partial) []Comparator {
+	var set []Comparator
+	if from.n > 0 {
+		set = append(set, Comparator{Op: OpGreaterEqual, Version: from.version()})
+	}
+	switch to.n {
+	case 1:
+		set = append(set, Comparator{Op: OpLess, Version: firstPreRelease(to.major+1, 0, 0)})
+	case 2:
+		set = append(set, Comparator{Op: OpLess, Version: firstPreRelease(to.major, to.minor+1, 0)})
+	case 3:
+		set = append(set, Comparator{Op: OpLessEqual, Version: to.version()})
+	}
+	if set == nil {
+		return []Comparator{anyComparator()}
+	}
+	return set
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for ParseConstraint desugaring
+func TestParseConstraint(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"1.2.3", "1.2.3"},
+		{"=1.2.3", "1.2.3"},
+		{"v1.2.3", "1.2.3"},
+		{">1.2.3", ">1.2.3"},
+		{">= 1.2.3", ">=1.2.3"},
+		{"<=1.2.3-beta", "<=1.2.3-beta"},
+		{"", ">=0.0.0"},
+		{"*", ">=0.0.0"},
+		{"x", ">=0.0.0"},
+		{"1", ">=1.0.0 <2.0.0-0"},
+		{"1.x", ">=1.0.0 <2.0.0-0"},
+		{"1.2", ">=1.2.0 <1.3.0-0"},
+		{"1.2.*", ">=1.2.0 <1.3.0-0"},
+		{">1", ">=2.0.0"},
+		{">1.2", ">=1.3.0"},
+		{">=1.2", ">=1.2.0"},
+		{"<1.2", "<1.2.0-0"},
+		{"<=1.2", "<1.3.0-0"},
+		{"<=1", "<2.0.0-0"},
+		{">*", "<0.0.0-0"},
+		{"~1.2.3", ">=1.2.3 <1.3.0-0"},
+		{"~>1.2.3", ">=1.2.3 <1.3.0-0"},
+		{"~1.2", ">=1.2.0 <1.3.0-0"},
+		{"~1", ">=1.0.0 <2.0.0-0"},
+		{"~0.2.3-beta", ">=0.2.3-beta <0.3.0-0"},
+		{"^1.2.3", ">=1.2.3 <2.0.0-0"},
+		{"^1.2.3-beta.2", ">=1.2.3-beta.2 <2.0.0-0"},
+		{"^0.2.3", ">=0.2.3 <0.3.0-0"},
+		{"^0.0.3", ">=0.0.3 <0.0.4-0"},
+		{"^0.0", ">=0.0.0 <0.1.0-0"},
+		{"^0.x", ">=0.0.0 <1.0.0-0"},
+		{"^1.2", ">=1.2.0 <2.0.0-0"},
+		{"^0.2", ">=0.2.0 <0.3.0-0"},
+		{"1.2.3 - 2.3.4", ">=1.2.3 <=2.3.4"},
+		{"1.2 - 2.3.4", ">=1.2.0 <=2.3.4"},
+		{"1.2.3 - 2.3", ">=1.2.3 <2.4.0-0"},
+		{"1.2.3 - 2", ">=1.2.3 <3.0.0-0"},
+		{"^1.2.3 || >=2.1.0 <3", ">=1.2.3 <2.0.0-0 || >=2.1.0 <3.0.0-0"},
+		{"1.2.7 || >=1.2.9 <2.0.0", "1.2.7 || >=1.2.9 <2.0.0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			c, err := semver.ParseConstraint(tc.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if actual := c.String(); actual != tc.expected {
+				t.Errorf("Unexpected constraint. expected: %q, actual: %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+// Test for ParseConstraint with invalid input
+func TestParseConstraintError(t *testing.T) {
+	testCases := []struct {
+		input  string
+		offset int
+	}{
+		{">=", 2},
+		{">= 01.2.3", 3},
+		{"1.2.3.4", 5},
+		{"1.x.3", 4},
+		{"1.2-beta", 3},
+		{"^1.2.3-beta..1", 12},
+		{"1.2.3 || >=1.2a", 14},
+		{"=>1.2.3", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			_, err := semver.ParseConstraint(tc.input)
+			var cerr *semver.ConstraintError
+			if !errors.As(err, &cerr) {
+				t.Fatalf("Expected *ConstraintError, got %v", err)
+			}
+			if cerr.Offset != tc.offset {
+				t.Errorf("Unexpected offset. expected: %d, actual: %d (%v)", tc.offset, cerr.Offset, cerr)
+			}
+		})
+	}
+}
+
+// Test for Constraint.Check
+func TestConstraintCheck(t *testing.T) {
+	testCases := []struct {
+		constraint string
+		version    string
+		expected   bool
+	}{
+		// examples from node-semver's range tests
+		{"1.0.0 - 2.0.0", "1.2.3", true},
+		{"^1.2.3+build", "1.2.3", true},
+		{"^1.2.3+build", "1.3.0", true},
+		{"1.2.3-pre+asdf - 2.4.3-pre+asdf", "1.2.3", true},
+		{"1.2.3-pre+asdf - 2.4.3-pre+asdf", "1.2.3-pre.2", true},
+		{"1.2.3-pre+asdf - 2.4.3-pre+asdf", "2.4.3-alpha", true},
+		{"1.2.3+asdf - 2.4.3+asdf", "1.2.3", true},
+		{"1.0.0", "1.0.0", true},
+		{">=*", "0.2.4", true},
+		{"", "1.0.0", true},
+		{"*", "1.2.3", true},
+		{">=1.0.0", "1.0.0", true},
+		{">=1.0.0", "1.1.0", true},
+		{">1.0.0", "1.0.1", true},
+		{"<=2.0.0", "2.0.0", true},
+		{"<=2.0.0", "0.2.9", true},
+		{"<2.0.0", "1.9999.9999", true},
+		{">= 1.0.0", "1.0.0", true},
+		{"> 1.0.0", "1.1.0", true},
+		{"<=   2.0.0", "2.0.0", true},
+		{"0.1.20 || 1.2.4", "1.2.4", true},
+		{">=0.2.3 || <0.0.1", "0.0.0", true},
+		{">=0.2.3 || <0.0.1", "0.2.3", true},
+		{"2.x.x", "2.1.3", true},
+		{"1.2.x", "1.2.3", true},
+		{"1.2.x || 2.x", "2.1.3", true},
+		{"x", "1.2.3", true},
+		{"2.*.*", "2.1.3", true},
+		{"2", "2.1.2", true},
+		{"2.3", "2.3.1", true},
+		{"~0.0.1", "0.0.1", true},
+		{"~0.0.1", "0.0.2", true},
+		{"~x", "0.0.9", true},
+		{"~2", "2.0.9", true},
+		{"~2.4", "2.4.5", true},
+		{"~>3.2.1", "3.2.2", true},
+		{"~1", "1.2.3", true},
+		{"~> 1", "1.2.3", true},
+		{"~1.0", "1.0.2", true},
+		{">=1", "1.0.0", true},
+		{"<1.2", "1.1.1", true},
+		{"~v0.5.4-pre", "0.5.5", true},
+		{"~v0.5.4-pre", "0.5.4", true},
+		{"=0.7.x", "0.7.2", true},
+		{"<=0.7.x", "0.7.2", true},
+		{">=0.7.x", "0.7.2", true},
+		{"<=0.7.x", "0.6.2", true},
+		{"~1.2.1 >=1.2.3", "1.2.3", true},
+		{">=0.2.3 <0.2.4 || 1.2.x", "0.2.3", true},
+		{"^1.2.3", "1.8.1", true},
+		{"^0.1.2", "0.1.2", true},
+		{"^0.1", "0.1.2", true},
+		{"^0.0.1", "0.0.1", true},
+		{"^1.2", "1.4.2", true},
+		{"^1.2 ^1", "1.4.2", true},
+		{"^1.2.3-alpha", "1.2.3-pre", true},
+		{"^1.2.0-alpha", "1.2.0-pre", true},
+		{"^0.0.1-alpha", "0.0.1-beta", true},
+		{"^0.0.1-alpha", "0.0.1", true},
+		{"^0.1.1-alpha", "0.1.1-beta", true},
+		{"^x", "1.2.3", true},
+		{"x - 1.0.0", "0.9.7", true},
+		{"x - 1.x", "0.9.7", true},
+		{"1.0.0 - x", "1.9.7", true},
+		{"1.x - x", "1.9.7", true},
+		{"<=7.x", "7.9.9", true},
+
+		{"1.0.0 - 2.0.0", "2.2.3", false},
+		{"1.2.3+asdf - 2.4.3+asdf", "1.2.3-pre.2", false},
+		{"1.2.3+asdf - 2.4.3+asdf", "2.4.3-alpha", false},
+		{"^1.2.3+build", "2.0.0", false},
+		{"^1.2.3+build", "1.2.0", false},
+		{"^1.2.3", "1.2.3-pre", false},
+		{"^1.2", "1.2.0-pre", false},
+		{">1.2", "1.3.0-beta", false},
+		{"<=1.2.3", "1.2.3-beta", false},
+		{"^1.2.3", "1.2.3-beta", false},
+		{"=0.7.x", "0.7.0-asdf", false},
+		{">=0.7.x", "0.7.0-asdf", false},
+		{"1.0.0", "1.0.1", false},
+		{">=1.0.0", "0.0.0", false},
+		{">=1.0.0", "0.0.1", false},
+		{">1.0.0", "1.0.0", false},
+		{"<=2.0.0", "3.0.0", false},
+		{"<2.0.0", "2.0.0", false},
+		{"0.1.20 || 1.2.4", "1.2.3", false},
+		{">=0.2.3 || <0.0.1", "0.0.3", false},
+		{"2.x.x", "1.1.3", false},
+		{"2.x.x", "3.1.3", false},
+		{"1.2.x", "1.3.3", false},
+		{"1.2.x || 2.x", "3.1.3", false},
+		{"2.*.*", "1.1.3", false},
+		{"2", "1.1.2", false},
+		{"2.3", "2.4.1", false},
+		{"~0.0.1", "0.1.0-alpha", false},
+		{"~0.0.1", "0.1.0", false},
+		{"~2.4", "2.5.0", false},
+		{"~2.4", "2.3.9", false},
+		{"~>3.2.1", "3.3.2", false},
+		{"~1", "0.2.3", false},
+		{"~>1", "2.2.3", false},
+		{"<1", "1.0.0", false},
+		{">=1.2", "1.1.1", false},
+		{"~v0.5.4-beta", "0.5.4-alpha", false},
+		{"=0.7.x", "0.8.2", false},
+		{"<0.7.x", "0.7.2", false},
+		{"<1.2.3", "1.2.3-beta", false},
+		{"=1.2.3", "1.2.3-beta", false},
+		{">1.2", "1.2.8", false},
+		{"^0.0.1", "0.0.2-alpha", false},
+		{"^0.0.1", "0.0.2", false},
+		{"^1.2.3", "2.0.0-alpha", false},
+		{"^1.2.3", "1.2.2", false},
+		{"^1.2", "1.1.9", false},
+		{"*", "1.2.3-foo", false},
+		{"^1.0.0", "2.0.0-rc1", false},
+		{"^1.0.0", "1.9.9-rc1", false},
+		{"<0.0.0-0", "0.0.0-0", false},
+		{">*", "1.0.0", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.constraint+" "+tc.version, func(t *testing.T) {
+			c := semver.MustParseConstraint(tc.constraint)
+			v := semver.MustParse(tc.version)
+			if actual := c.Check(v); actual != tc.expected {
+				t.Errorf("Unexpected result for %q (%s). expected: %v, actual: %v", tc.constraint, c, tc.expected, actual)
+			}
+		})
+	}
+}
+
+// Test for Constraint.IncludePreRelease
+func TestConstraintIncludePreRelease(t *testing.T) {
+	testCases := []struct {
+		constraint string
+		version    string
+		expected   bool
+	}{
+		{"^1.2.3", "1.5.0-beta", true},
+		{"*", "1.2.3-foo", true},
+		{">1.2", "1.3.0-beta", false}, // bounds are not widened to 1.3.0-0
+		{"^1.0.0", "2.0.0-rc1", false},
+		{"<1.2.3", "1.2.3-beta", true},
+		{"^1.2.3", "1.2.3-beta", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.constraint+" "+tc.version, func(t *testing.T) {
+			c := semver.MustParseConstraint(tc.constraint)
+			c.IncludePreRelease = true
+			if actual := c.Check(semver.MustParse(tc.version)); actual != tc.expected {
+				t.Errorf("Unexpected result for %q (%s). expected: %v, actual: %v", tc.constraint, c, tc.expected, actual)
+			}
+		})
+	}
+}
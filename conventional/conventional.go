@@ -0,0 +1,357 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+// Package conventional decides the next version from commit messages that
+// follow Conventional Commits (https://www.conventionalcommits.org/).
+//
+// Each message is classified by its type and breaking change markers, and
+// the highest classification decides the bump:
+//
+//   - "feat" is a minor bump
+//   - "fix" and "perf" are patch bumps
+//   - a "!" before the colon, as in "feat(api)!: ...", or a "BREAKING CHANGE:"
+//     footer is a major bump, which is demoted to minor while the major
+//     version is 0, since 0.y.z is initial development
+//
+// Other types, such as "docs" and "chore", and messages that are not
+// Conventional Commits don't call for a release. The rules are configurable
+// through Rules, and a Decision records the rule each commit matched so
+// the result can be traced to the commits that caused it.
+//
+// Example:
+//
+//	messages, _ := conventional.ReadMessages(os.Stdin) // git log --format=%B%x00 v1.4.2..HEAD
+//	d := conventional.Decide(semver.MustParse("1.4.2"), messages, conventional.DefaultRules())
+//	fmt.Println(d.Level, d.Next) // minor 1.5.0
+//	for _, c := range d.Causes() {
+//		fmt.Println(c.Rule, c.Commit.Header)
+//	}
+package conventional
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/maloquacious/semver"
+)
+
+// Level is the size of the release a commit calls for.
+// Levels are ordered, so the highest level among the commits wins.
+type Level int
+
+const (
+	None  Level = iota // no release, as for "docs" or "chore"
+	Patch              // a patch release, as for "fix"
+	Minor              // a minor release, as for "feat"
+	Major              // a major release, as for a breaking change
+)
+
+// String implements the fmt.Stringer interface.
+func (l Level) String() string {
+	switch l {
+	case None:
+		return "none"
+	case Patch:
+		return "patch"
+	case Minor:
+		return "minor"
+	case Major:
+		return "major"
+	}
+	return fmt.Sprintf("Level(%d)", int(l))
+}
+
+// BumpKind returns the semver.BumpKind for the level.
+// The boolean is false for None, which does not bump.
+func (l Level) BumpKind() (semver.BumpKind, bool) {
+	switch l {
+	case Patch:
+		return semver.BumpPatch, true
+	case Minor:
+		return semver.BumpMinor, true
+	case Major:
+		return semver.BumpMajor, true
+	}
+	return 0, false
+}
+
+// Footer is a git trailer style footer of a commit message, such as
+// "Refs: #123" or "BREAKING CHANGE: drop the v1 API".
+type Footer struct {
+	Token string // "Refs", "BREAKING CHANGE"
+	Value string // the text after the separator, including continuation lines
+}
+
+// Commit is a commit message in Conventional Commits form.
+type Commit struct {
+	Header   string   // the first line of the message
+	Type     string   // the type, in lower case, such as "feat"
+	Scope    string   // the scope between parentheses, or ""
+	Bang     bool     // the header has "!" before the colon
+	Subject  string   // the description after ": "
+	Body     string   // the text between the header and the footers
+	Footers  []Footer // the footers, in order
+	Breaking bool     // Bang is set or there is a BREAKING CHANGE footer
+}
+
+// ParseCommit parses a commit message. It returns an error if the header
+// is not "type(scope)!: description", where the scope and "!" are optional.
+func ParseCommit(msg string) (Commit, error) {
+	msg = strings.TrimSpace(strings.ReplaceAll(msg, "\r\n", "\n"))
+	header, rest, _ := strings.Cut(msg, "\n")
+	c := Commit{Header: strings.TrimSpace(header)}
+	invalid := func(why string) (Commit, error) {
+		return Commit{}, fmt.Errorf("conventional: %q is not a conventional commit: %s", c.Header, why)
+	}
+
+	h := c.Header
+	i := 0
+	for i < len(h) && (isLetter(h[i]) || (i > 0 && h[i] == '-')) {
+		i++
+	}
+	if i == 0 {
+		return invalid("missing type")
+	}
+	c.Type, h = strings.ToLower(h[:i]), h[i:]
+	if strings.HasPrefix(h, "(") {
+		end := strings.IndexByte(h, ')')
+		if end == -1 {
+			return invalid("unterminated scope")
+		} else if end == 1 {
+			return invalid("empty scope")
+		}
+		c.Scope, h = h[1:end], h[end+1:]
+	}
+	c.Bang = strings.HasPrefix(h, "!")
+	h = strings.TrimPrefix(h, "!")
+	h, ok := strings.CutPrefix(h, ":")
+	if !ok {
+		return invalid(`missing ":" after the type`)
+	} else if c.Subject = strings.TrimSpace(h); c.Subject == "" {
+		return invalid("missing description")
+	} else if h[0] != ' ' {
+		return invalid(`missing space after ":"`)
+	}
+
+	c.Body, c.Footers = splitFooters(strings.TrimSpace(rest))
+	c.Breaking = c.Bang
+	for _, f := range c.Footers {
+		if isBreakingToken(f.Token) {
+			c.Breaking = true
+		}
+	}
+	return c, nil
+}
+
+// splitFooters separates the footers, which make up the last paragraph
+// of the text when its first line is a footer, from the body.
+func splitFooters(text string) (string, []Footer) {
+	body, last := "", text
+	if i := strings.LastIndex(text, "\n\n"); i != -1 {
+		body, last = strings.TrimSpace(text[:i]), text[i+2:]
+	}
+	lines := strings.Split(last, "\n")
+	if _, _, ok := cutFooter(lines[0]); !ok {
+		return text, nil
+	}
+	var footers []Footer
+	for _, line := range lines {
+		if token, value, ok := cutFooter(line); ok {
+			footers = append(footers, Footer{Token: token, Value: value})
+		} else {
+			// a continuation of the previous footer
+			footers[len(footers)-1].Value += "\n" + line
+		}
+	}
+	return body, footers
+}
+
+// cutFooter splits a line of the form "Token: value" or "Token #value".
+// A token is a word, with "-" for spaces, except for "BREAKING CHANGE".
+func cutFooter(line string) (token, value string, ok bool) {
+	for _, sep := range []string{": ", " #"} {
+		if token, value, ok = strings.Cut(line, sep); ok && (isBreakingToken(token) || isToken(token)) {
+			if sep == " #" {
+				value = "#" + value
+			}
+			return token, value, true
+		}
+	}
+	return "", "", false
+}
+
+// isBreakingToken reports whether token marks a breaking change.
+// The specification requires it in upper case.
+func isBreakingToken(token string) bool {
+	return token == "BREAKING CHANGE" || token == "BREAKING-CHANGE"
+}
+
+// isToken reports whether s is a footer token: letters, digits and "-".
+func isToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isLetter(s[i]) && !('0' <= s[i] && s[i] <= '9') && s[i] != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// isLetter reports whether ch is an ASCII letter.
+func isLetter(ch byte) bool {
+	return ('a' <= ch && ch <= 'z') || ('A' <= ch && ch <= 'Z')
+}
+
+// Rules decide the level of each commit.
+type Rules struct {
+	// Types maps a commit type, in lower case, to its level.
+	// Types that are not listed are None.
+	Types map[string]Level
+	// Breaking is the level of a breaking change, whatever its type.
+	Breaking Level
+	// DemoteInitialDevelopment demotes Major to Minor while the major
+	// version is 0, as breaking changes are expected during initial
+	// development (https://semver.org/#spec-item-4).
+	DemoteInitialDevelopment bool
+}
+
+// DefaultRules returns the rules described in the package documentation.
+// The result is a fresh copy that may be changed, for example to make
+// "refactor" a patch release:
+//
+//	rules := conventional.DefaultRules()
+//	rules.Types["refactor"] = conventional.Patch
+func DefaultRules() Rules {
+	return Rules{
+		Types: map[string]Level{
+			"feat": Minor,
+			"fix":  Patch,
+			"perf": Patch,
+		},
+		Breaking:                 Major,
+		DemoteInitialDevelopment: true,
+	}
+}
+
+// Classified is a commit message along with the level the rules gave it.
+type Classified struct {
+	Message string // the message as given
+	Commit  Commit // the parsed message, if Err is nil
+	Level   Level  // the level of the commit; None if Err is set
+	Rule    string // the rule that set Level, such as `type "feat"`, or "" for None
+	Err     error  // the error from ParseCommit for a message that is not a conventional commit
+}
+
+// Classify parses msg and gives it a level using the rules.
+// A message that is not a conventional commit is None, with Err set.
+func (r Rules) Classify(msg string) Classified {
+	c := Classified{Message: msg}
+	if c.Commit, c.Err = ParseCommit(msg); c.Err != nil {
+		return c
+	}
+	if level, ok := r.Types[c.Commit.Type]; ok && level != None {
+		c.Level, c.Rule = level, fmt.Sprintf("type %q", c.Commit.Type)
+	}
+	if c.Commit.Breaking && r.Breaking > c.Level {
+		c.Level, c.Rule = r.Breaking, `"!" in the header`
+		if !c.Commit.Bang {
+			c.Rule = "BREAKING CHANGE footer"
+		}
+	}
+	return c
+}
+
+// Decision is the bump called for by a list of commits.
+type Decision struct {
+	Level   Level          // the level of the release, after demotion; None if no commit calls for one
+	Demoted bool           // a Major level was demoted to Minor because the last version is 0.y.z
+	Last    semver.Version // the version the commits follow
+	Next    semver.Version // the next version; Last when Level is None
+	Commits []Classified   // every commit, in the order given
+}
+
+// Kind returns the semver.BumpKind that turns Last into Next.
+// The boolean is false when Level is None.
+func (d Decision) Kind() (semver.BumpKind, bool) {
+	return d.Level.BumpKind()
+}
+
+// Causes returns the commits that decided the level: those at the highest
+// level before any demotion. It returns nil when Level is None.
+func (d Decision) Causes() []Classified {
+	var top Level
+	for _, c := range d.Commits {
+		top = max(top, c.Level)
+	}
+	if top == None {
+		return nil
+	}
+	var causes []Classified
+	for _, c := range d.Commits {
+		if c.Level == top {
+			causes = append(causes, c)
+		}
+	}
+	return causes
+}
+
+// Decide classifies the commit messages made since last, usually the
+// latest tag, and returns the release they call for. The next version is
+// last bumped with semver.Version.Bump, so a pre-release such as
+// 1.5.0-rc.1 is released as 1.5.0 by a feat or fix.
+func Decide(last semver.Version, messages []string, rules Rules) Decision {
+	d := Decision{Last: last, Next: last}
+	for _, msg := range messages {
+		c := rules.Classify(msg)
+		d.Level = max(d.Level, c.Level)
+		d.Commits = append(d.Commits, c)
+	}
+	if d.Level == Major && last.Major == 0 && rules.DemoteInitialDevelopment {
+		d.Level, d.Demoted = Minor, true
+	}
+	if kind, ok := d.Level.BumpKind(); ok {
+		// Bump only fails for invalid options, and none are given
+		d.Next, _ = last.Bump(kind, semver.BumpOptions{})
+	}
+	return d
+}
+
+// ReadMessages reads commit messages from r. Messages separated by NUL
+// bytes, as written by "git log --format=%B%x00", may span several lines;
+// without NUL bytes, each non-blank line is a message, as written by
+// "git log --format=%s". Blank messages are dropped.
+func ReadMessages(r io.Reader) ([]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("conventional: reading messages: %w", err)
+	}
+	var messages []string
+	if bytes.IndexByte(data, 0) != -1 {
+		for _, msg := range bytes.Split(data, []byte{0}) {
+			if msg := strings.TrimSpace(string(msg)); msg != "" {
+				messages = append(messages, msg)
+			}
+		}
+		return messages, nil
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if msg := strings.TrimSpace(scanner.Text()); msg != "" {
+			messages = append(messages, msg)
+		}
+	}
+	return messages, scanner.Err()
+}
+
+// DecideReader is Decide with the messages read by ReadMessages.
+func DecideReader(last semver.Version, r io.Reader, rules Rules) (Decision, error) {
+	messages, err := ReadMessages(r)
+	if err != nil {
+		return Decision{}, err
+	}
+	return Decide(last, messages, rules), nil
+}
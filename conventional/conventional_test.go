@@ -0,0 +1,195 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package conventional_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/maloquacious/semver"
+	"github.com/maloquacious/semver/conventional"
+)
+
+// Test for ParseCommit
+func TestParseCommit(t *testing.T) {
+	testCases := []struct {
+		msg      string
+		expected conventional.Commit
+	}{
+		{"feat: add the bump command", conventional.Commit{
+			Header: "feat: add the bump command", Type: "feat", Subject: "add the bump command"}},
+		{"Fix(parser)!: reject leading zeros", conventional.Commit{
+			Header: "Fix(parser)!: reject leading zeros", Type: "fix", Scope: "parser", Bang: true, Subject: "reject leading zeros", Breaking: true}},
+		{"chore(deps): bump x\n\nThe body\nhas two lines.\n\nReviewed-by: Z\nRefs #133", conventional.Commit{
+			Header: "chore(deps): bump x", Type: "chore", Scope: "deps", Subject: "bump x", Body: "The body\nhas two lines.",
+			Footers: []conventional.Footer{{"Reviewed-by", "Z"}, {"Refs", "#133"}}}},
+		{"refactor: drop v1\r\n\r\nBREAKING CHANGE: the v1 API is gone,\r\nuse v2 instead", conventional.Commit{
+			Header: "refactor: drop v1", Type: "refactor", Subject: "drop v1",
+			Footers: []conventional.Footer{{"BREAKING CHANGE", "the v1 API is gone,\nuse v2 instead"}}, Breaking: true}},
+		{"docs: explain\n\nNot a footer: because the token has a space", conventional.Commit{
+			Header: "docs: explain", Type: "docs", Subject: "explain", Body: "Not a footer: because the token has a space"}},
+		{"fix: x\n\nbreaking change: in lower case this is not a footer", conventional.Commit{
+			Header: "fix: x", Type: "fix", Subject: "x", Body: "breaking change: in lower case this is not a footer"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected.Header, func(t *testing.T) {
+			actual, err := conventional.ParseCommit(tc.msg)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("Expected %+v\nactual   %+v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+// Test that ParseCommit rejects messages that are not conventional commits
+func TestParseCommitErrors(t *testing.T) {
+	testCases := []struct {
+		msg   string
+		error string
+	}{
+		{"", "missing type"},
+		{"Merge branch 'main'", `missing ":"`},
+		{"fix:no space", `missing space`},
+		{"feat(: x", "unterminated scope"},
+		{"feat(): x", "empty scope"},
+		{"fix: ", "missing description"},
+		{"(scope): x", "missing type"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			_, err := conventional.ParseCommit(tc.msg)
+			if err == nil || !strings.Contains(err.Error(), tc.error) {
+				t.Errorf("Expected error containing %q, actual %v", tc.error, err)
+			}
+		})
+	}
+}
+
+// Test for Decide with the default rules
+func TestDecide(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		last     string
+		messages []string
+		level    conventional.Level
+		demoted  bool
+		next     string
+	}{
+		{"nothing to release", "1.4.2", []string{"docs: typo", "chore: tidy", "Merge branch 'x'"}, conventional.None, false, "1.4.2"},
+		{"fix", "1.4.2", []string{"docs: typo", "fix: crash"}, conventional.Patch, false, "1.4.3"},
+		{"perf", "1.4.2", []string{"perf: faster"}, conventional.Patch, false, "1.4.3"},
+		{"feat", "1.4.2", []string{"fix: crash", "feat(cli): sort"}, conventional.Minor, false, "1.5.0"},
+		{"bang", "1.4.2", []string{"feat: a", "refactor!: drop v1"}, conventional.Major, false, "2.0.0"},
+		{"footer", "1.4.2", []string{"fix: a\n\nBREAKING-CHANGE: b"}, conventional.Major, false, "2.0.0"},
+		{"demoted in 0.x", "0.3.1", []string{"feat!: new API"}, conventional.Minor, true, "0.4.0"},
+		{"feat in 0.x", "0.3.1", []string{"feat: x"}, conventional.Minor, false, "0.4.0"},
+		{"pre-release", "1.5.0-rc.1", []string{"fix: a"}, conventional.Patch, false, "1.5.0"},
+		{"no commits", "1.0.0", nil, conventional.None, false, "1.0.0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			d := conventional.Decide(semver.MustParse(tc.last), tc.messages, conventional.DefaultRules())
+			if d.Level != tc.level || d.Demoted != tc.demoted || d.Next.String() != tc.next {
+				t.Errorf("Expected %s, demoted %v, next %s, actual %s, %v, %s", tc.level, tc.demoted, tc.next, d.Level, d.Demoted, d.Next)
+			}
+			if len(d.Commits) != len(tc.messages) {
+				t.Errorf("Expected %d classified commits, actual %d", len(tc.messages), len(d.Commits))
+			}
+			kind, ok := d.Kind()
+			if expected, expectedOK := tc.level.BumpKind(); kind != expected || ok != expectedOK {
+				t.Errorf("Kind: expected %v, %v, actual %v, %v", expected, expectedOK, kind, ok)
+			}
+		})
+	}
+}
+
+// Test that a decision can be traced to the commits that caused it
+func TestCauses(t *testing.T) {
+	messages := []string{
+		"fix: crash",
+		"feat(cli): sort",
+		"wip",
+		"feat: bump",
+		"docs!: rewrite\n\nthe docs moved",
+	}
+	d := conventional.Decide(semver.MustParse("0.9.0"), messages, conventional.DefaultRules())
+	if d.Level != conventional.Minor || !d.Demoted || d.Next.String() != "0.10.0" {
+		t.Fatalf("Expected a demoted minor bump to 0.10.0, actual %s, %v, %s", d.Level, d.Demoted, d.Next)
+	}
+	causes := d.Causes()
+	if len(causes) != 1 || causes[0].Message != messages[4] || causes[0].Rule != `"!" in the header` {
+		t.Errorf("Expected the breaking docs commit as the cause, actual %+v", causes)
+	}
+	if d.Commits[2].Err == nil || d.Commits[2].Level != conventional.None {
+		t.Errorf("Expected wip to be reported as not conventional, actual %+v", d.Commits[2])
+	}
+	if rule := d.Commits[1].Rule; rule != `type "feat"` {
+		t.Errorf(`Expected rule type "feat", actual %q`, rule)
+	}
+
+	d = conventional.Decide(semver.MustParse("1.0.0"), []string{"docs: x"}, conventional.DefaultRules())
+	if causes := d.Causes(); causes != nil {
+		t.Errorf("Expected no causes, actual %+v", causes)
+	}
+}
+
+// Test that the rules can be changed
+func TestCustomRules(t *testing.T) {
+	rules := conventional.DefaultRules()
+	rules.Types["refactor"] = conventional.Patch
+	rules.Types["feat"] = conventional.Patch
+	rules.DemoteInitialDevelopment = false
+
+	testCases := []struct {
+		last     string
+		messages []string
+		next     string
+	}{
+		{"1.0.0", []string{"refactor: x"}, "1.0.1"},
+		{"1.0.0", []string{"feat: x"}, "1.0.1"},
+		{"0.3.0", []string{"fix!: x"}, "1.0.0"},
+	}
+	for _, tc := range testCases {
+		if d := conventional.Decide(semver.MustParse(tc.last), tc.messages, rules); d.Next.String() != tc.next {
+			t.Errorf("Decide(%s, %q): expected %s, actual %s", tc.last, tc.messages, tc.next, d.Next)
+		}
+	}
+
+	rules.Breaking = conventional.Minor
+	if d := conventional.Decide(semver.MustParse("1.0.0"), []string{"fix!: x"}, rules); d.Next.String() != "1.1.0" {
+		t.Errorf("Expected breaking changes to be minor, actual %s", d.Next)
+	}
+	if rules := conventional.DefaultRules(); rules.Types["refactor"] != conventional.None {
+		t.Errorf("Expected DefaultRules to return a fresh copy")
+	}
+}
+
+// Test for ReadMessages and DecideReader
+func TestReadMessages(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected []string
+	}{
+		{"feat: a\n\nbody\x00\nfix: b\n\x00\n", []string{"feat: a\n\nbody", "fix: b"}},
+		{"feat: a\n\nfix: b\n", []string{"feat: a", "fix: b"}},
+		{"", nil},
+	}
+	for _, tc := range testCases {
+		actual, err := conventional.ReadMessages(strings.NewReader(tc.input))
+		if err != nil || !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("ReadMessages(%q): expected %q, actual %q (%v)", tc.input, tc.expected, actual, err)
+		}
+	}
+
+	d, err := conventional.DecideReader(semver.MustParse("2.1.0"), strings.NewReader("fix: a\x00feat: b\n\nBREAKING CHANGE: c\x00"), conventional.DefaultRules())
+	if err != nil || d.Next.String() != "3.0.0" {
+		t.Errorf("DecideReader: expected 3.0.0, actual %s (%v)", d.Next, err)
+	}
+}
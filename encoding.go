@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The version is encoded with String(). An invalid version is
+// rejected with the error from Validate.
+//
+// Because Version implements encoding.TextMarshaler and encoding.TextUnmarshaler,
+// it works directly with encoding/json, encoding/xml and flag.TextVar.
+func (v Version) MarshalText() ([]byte, error) {
+	if err := v.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The text is parsed with the strict Parse function.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts either a JSON string, which is parsed with Parse,
+// or a JSON object in the form produced by JSONObject.
+// By the convention of encoding/json, null leaves v unchanged.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	if data = bytes.TrimSpace(data); isJSONNull(data) {
+		return nil
+	} else if len(data) != 0 && data[0] == '{' {
+		var obj JSONObject
+		if err := obj.UnmarshalJSON(data); err != nil {
+			return err
+		}
+		*v = Version(obj)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(s))
+}
+
+// JSONObject is a Version that marshals to JSON as a structured object
+// instead of a string, for consumers that can't parse version strings.
+//
+// Example:
+//
+//	json.Marshal(semver.JSONObject(semver.MustParse("1.2.3-rc.1")))
+//	// {"major":1,"minor":2,"patch":3,"prerelease":"rc.1","build":""}
+type JSONObject Version
+
+// jsonObject is the wire format for JSONObject.
+type jsonObject struct {
+	Major      int    `json:"major"`
+	Minor      int    `json:"minor"`
+	Patch      int    `json:"patch"`
+	PreRelease string `json:"prerelease"`
+	Build      string `json:"build"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// An invalid version is rejected with the error from Validate.
+func (o JSONObject) MarshalJSON() ([]byte, error) {
+	if err := Version(o).Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(jsonObject(o))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The decoded version is checked with Validate. Like Version.UnmarshalJSON,
+// it leaves o unchanged for null.
+func (o *JSONObject) UnmarshalJSON(data []byte) error {
+	if isJSONNull(bytes.TrimSpace(data)) {
+		return nil
+	}
+	var obj jsonObject
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	if err := Version(obj).Validate(); err != nil {
+		return err
+	}
+	*o = JSONObject(obj)
+	return nil
+}
+
+// isJSONNull reports whether data, without surrounding space, is the JSON null.
+func isJSONNull(data []byte) bool {
+	return string(data) == "null"
+}
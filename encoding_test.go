@@ -0,0 +1,153 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for JSON encoding through MarshalText and UnmarshalText
+func TestJSON(t *testing.T) {
+	type config struct {
+		Version semver.Version `json:"version"`
+	}
+	testCases := []struct {
+		desc     string
+		input    string
+		expected semver.Version
+		output   string
+	}{
+		{
+			desc:     "string form",
+			input:    `{"version":"1.2.3-rc.1+b5"}`,
+			expected: semver.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1", Build: "b5"},
+			output:   `{"version":"1.2.3-rc.1+b5"}`,
+		},
+		{
+			desc:     "object form",
+			input:    `{"version":{"major":1,"minor":2,"patch":3,"prerelease":"rc.1"}}`,
+			expected: semver.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1"},
+			output:   `{"version":"1.2.3-rc.1"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var cfg config
+			if err := json.Unmarshal([]byte(tc.input), &cfg); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !cfg.Version.Equal(tc.expected) {
+				t.Errorf("Unexpected version. expected: %v, actual: %v", tc.expected, cfg.Version)
+			}
+			data, err := json.Marshal(cfg)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(data) != tc.output {
+				t.Errorf("Unexpected JSON. expected: %s, actual: %s", tc.output, data)
+			}
+		})
+	}
+}
+
+// Test that JSON null leaves a version unchanged
+func TestJSONNull(t *testing.T) {
+	v := semver.MustParse("1.2.3")
+	cfg := struct {
+		Version semver.Version    `json:"version"`
+		Object  semver.JSONObject `json:"object"`
+	}{v, semver.JSONObject(v)}
+	if err := json.Unmarshal([]byte(`{"version":null,"object": null }`), &cfg); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !cfg.Version.Equal(v) || !semver.Version(cfg.Object).Equal(v) {
+		t.Errorf("Expected 1.2.3 unchanged, actual %v and %v", cfg.Version, semver.Version(cfg.Object))
+	}
+	for _, data := range []string{"null", " null\n"} {
+		w := v
+		if err := w.UnmarshalJSON([]byte(data)); err != nil || !w.Equal(v) {
+			t.Errorf("UnmarshalJSON(%q): expected 1.2.3 unchanged, actual %v (%v)", data, w, err)
+		}
+	}
+}
+
+// Test for JSON encoding errors
+func TestJSONError(t *testing.T) {
+	var v semver.Version
+	for _, input := range []string{`"1.2"`, `"v1.2.3"`, `{"major":-1}`, `{"major":1,"prerelease":"a..b"}`, `12`} {
+		if err := json.Unmarshal([]byte(input), &v); err == nil {
+			t.Errorf("Expected error for %s", input)
+		}
+	}
+	if _, err := json.Marshal(semver.Version{Major: 1, Build: "a..b"}); err == nil {
+		t.Errorf("Expected error marshaling invalid version")
+	}
+}
+
+// Test for JSONObject encoding
+func TestJSONObject(t *testing.T) {
+	v := semver.MustParse("1.2.3-rc.1+b5")
+	data, err := json.Marshal(semver.JSONObject(v))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `{"major":1,"minor":2,"patch":3,"prerelease":"rc.1","build":"b5"}`
+	if string(data) != expected {
+		t.Errorf("Unexpected JSON. expected: %s, actual: %s", expected, data)
+	}
+	var obj semver.JSONObject
+	if err := json.Unmarshal(data, &obj); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !semver.Version(obj).Equal(v) {
+		t.Errorf("Round trip failed. expected: %v, actual: %v", v, semver.Version(obj))
+	}
+}
+
+// Test for XML encoding through MarshalText and UnmarshalText
+func TestXML(t *testing.T) {
+	type manifest struct {
+		XMLName xml.Name       `xml:"manifest"`
+		Version semver.Version `xml:"version,attr"`
+		Min     semver.Version `xml:"min"`
+	}
+	input := `<manifest version="2.0.0-beta"><min>1.4.0</min></manifest>`
+	var m manifest
+	if err := xml.Unmarshal([]byte(input), &m); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if m.Version.String() != "2.0.0-beta" || m.Min.String() != "1.4.0" {
+		t.Errorf("Unexpected versions: %v, %v", m.Version, m.Min)
+	}
+	data, err := xml.Marshal(m)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != input {
+		t.Errorf("Unexpected XML. expected: %s, actual: %s", input, data)
+	}
+}
+
+// Test for flag.TextVar support
+func TestFlagTextVar(t *testing.T) {
+	var v semver.Version
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.TextVar(&v, "version", semver.Version{Major: 1}, "version to release")
+	if err := fs.Parse([]string{"-version", "3.1.4-rc.2"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if v.String() != "3.1.4-rc.2" {
+		t.Errorf("Unexpected version: %v", v)
+	}
+	fs.SetOutput(io.Discard)
+	if err := fs.Parse([]string{"-version", "3.1"}); err == nil {
+		t.Errorf("Expected error for invalid flag value")
+	}
+}
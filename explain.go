@@ -0,0 +1,221 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Rule identifies the precedence rule that decided whether a version
+// satisfies a comparator. The rules follow the steps of Compare.
+type Rule int
+
+const (
+	RuleSatisfied          Rule = iota // the version satisfies the constraint
+	RuleMajor                          // the major versions differ (https://semver.org/#spec-item-11)
+	RuleMinor                          // the major versions are equal and the minor versions differ
+	RulePatch                          // the major and minor versions are equal and the patch versions differ
+	RuleRelease                        // one version is a pre-release and the other a normal release of the same core
+	RulePreRelease                     // both are pre-releases of the same core and their identifiers differ
+	RuleEqual                          // the versions have the same precedence; build metadata is ignored
+	RulePreReleaseExcluded             // the version is a pre-release and the comparator set does not admit it
+)
+
+// String implements the fmt.Stringer interface.
+func (r Rule) String() string {
+	switch r {
+	case RuleSatisfied:
+		return "satisfied"
+	case RuleMajor:
+		return "major version precedence"
+	case RuleMinor:
+		return "minor version precedence"
+	case RulePatch:
+		return "patch version precedence"
+	case RuleRelease:
+		return "release has precedence over pre-release"
+	case RulePreRelease:
+		return "pre-release identifier precedence"
+	case RuleEqual:
+		return "equal precedence"
+	case RulePreReleaseExcluded:
+		return "pre-release excluded"
+	}
+	return fmt.Sprintf("Rule(%d)", int(r))
+}
+
+// Explanation describes why a version does or does not satisfy a Constraint.
+type Explanation struct {
+	Version   Version
+	Satisfied bool
+	// Set is the index into Constraint.Sets of the set that matched or,
+	// when nothing matched, of the closest set: the one with the fewest
+	// failing comparators. Ties go to the set whose first failure was
+	// decided latest in Compare (a patch difference is closer than a
+	// major difference), and then to the earlier set.
+	Set int
+	// Failed lists the comparators in Set that Version does not satisfy.
+	// It is empty when the version matched, or when only the pre-release
+	// rule excluded it.
+	Failed []Comparator
+	// Rule is the precedence rule that decided the first failure in Failed,
+	// RulePreReleaseExcluded if only the pre-release rule failed, or
+	// RuleSatisfied if the version matched. The rules are ordered from
+	// the first step of Compare to the last.
+	Rule Rule
+	// Reason is a human-readable sentence citing the deciding rule.
+	Reason string
+}
+
+// String implements the fmt.Stringer interface and returns the Reason.
+func (e Explanation) String() string {
+	return e.Reason
+}
+
+// Explain reports whether v satisfies the constraint and why.
+// Unlike Check, it examines every comparator set and returns
+// the matching set or, if there isn't one, the closest set.
+//
+// Example:
+//
+//	c := MustParseConstraint("^1.5.0-rc.1")
+//	c.Explain(MustParse("1.4.0-beta")).Reason
+//	// "1.4.0-beta does not satisfy >=1.5.0-rc.1: minor version 4 is less than 5"
+//	c.Explain(MustParse("1.6.0-beta")).Reason
+//	// "1.6.0-beta is a pre-release and the range only admits pre-releases of 1.5.0"
+func (c Constraint) Explain(v Version) Explanation {
+	best := Explanation{Version: v, Set: -1}
+	for i, set := range c.Sets {
+		var failed []Comparator
+		for _, cmp := range set {
+			if !cmp.Check(v) {
+				failed = append(failed, cmp)
+			}
+		}
+		if len(failed) == 0 && (v.PreRelease == "" || c.IncludePreRelease || preReleaseAllowed(set, v)) {
+			return Explanation{
+				Version:   v,
+				Satisfied: true,
+				Set:       i,
+				Rule:      RuleSatisfied,
+				Reason:    fmt.Sprintf("%s satisfies %s", v, formatSet(set)),
+			}
+		}
+		var rule Rule
+		var reason string
+		if len(failed) == 0 {
+			rule, reason = RulePreReleaseExcluded, explainPreReleaseExcluded(set, v)
+		} else {
+			var detail string
+			rule, detail = explainPrecedence(v, failed[0].Version)
+			reason = fmt.Sprintf("%s does not satisfy %s: %s", v, failed[0], detail)
+		}
+		if best.Set == -1 || len(failed) < len(best.Failed) || (len(failed) == len(best.Failed) && rule > best.Rule) {
+			best.Set, best.Failed, best.Rule, best.Reason = i, failed, rule, reason
+		}
+	}
+	if best.Set == -1 {
+		best.Reason = fmt.Sprintf("%s does not satisfy an empty constraint", v)
+	}
+	return best
+}
+
+// formatSet returns a comparator set in the form used by Constraint.String.
+func formatSet(set []Comparator) string {
+	cmps := make([]string, len(set))
+	for i, cmp := range set {
+		cmps[i] = cmp.String()
+	}
+	return strings.Join(cmps, " ")
+}
+
+// explainPreReleaseExcluded describes why the node-semver pre-release rule rejected v.
+// Upper bounds such as "<2.0.0-0" have a pre-release but admit none of their own,
+// so they are left out of the list of admitted cores.
+func explainPreReleaseExcluded(set []Comparator, v Version) string {
+	var cores []string
+	for _, cmp := range set {
+		if cmp.Version.PreRelease == "" || (cmp.Op == OpLess && cmp.Version.PreRelease == "0") {
+			continue
+		}
+		if core := cmp.Version.Core(); !slices.Contains(cores, core) {
+			cores = append(cores, core)
+		}
+	}
+	if cores == nil {
+		return fmt.Sprintf("%s is a pre-release and the range admits no pre-releases", v)
+	}
+	return fmt.Sprintf("%s is a pre-release and the range only admits pre-releases of %s", v, strings.Join(cores, " and "))
+}
+
+// explainPrecedence walks the steps of Compare for v and w and returns the
+// rule that decided their order along with a description of the difference.
+func explainPrecedence(v, w Version) (Rule, string) {
+	for _, n := range []struct {
+		rule Rule
+		name string
+		a, b int
+	}{
+		{RuleMajor, "major", v.Major, w.Major},
+		{RuleMinor, "minor", v.Minor, w.Minor},
+		{RulePatch, "patch", v.Patch, w.Patch},
+	} {
+		if n.a != n.b {
+			return n.rule, fmt.Sprintf("%s version %d is %s %d", n.name, n.a, lessOrGreater(n.a < n.b), n.b)
+		}
+	}
+
+	if v.PreRelease == "" && w.PreRelease == "" {
+		return RuleEqual, fmt.Sprintf("it has the same precedence as %s", w)
+	} else if v.PreRelease == "" {
+		return RuleRelease, fmt.Sprintf("a release has higher precedence than its pre-release %s", w)
+	} else if w.PreRelease == "" {
+		return RuleRelease, fmt.Sprintf("a pre-release has lower precedence than its release %s", w)
+	}
+
+	fields1 := strings.Split(v.PreRelease, ".")
+	fields2 := strings.Split(w.PreRelease, ".")
+	for i := 0; i < len(fields1) && i < len(fields2); i++ {
+		// compare single identifiers the way Compare does so the explanation
+		// always agrees with the precedence rules it is describing
+		cmp := compareIdentifier(fields1[i], fields2[i])
+		if cmp == 0 {
+			continue
+		}
+		num1, num2 := isNumeric(fields1[i]), isNumeric(fields2[i])
+		switch {
+		case num1 && num2:
+			return RulePreRelease, fmt.Sprintf("pre-release identifier %s is numerically %s %s", fields1[i], lessOrGreater(cmp < 0), fields2[i])
+		case num1 || num2:
+			return RulePreRelease, fmt.Sprintf("pre-release identifier %q sorts %s %q because numeric identifiers have lower precedence", fields1[i], beforeOrAfter(cmp < 0), fields2[i])
+		}
+		return RulePreRelease, fmt.Sprintf("pre-release identifier %q sorts %s %q in ASCII order", fields1[i], beforeOrAfter(cmp < 0), fields2[i])
+	}
+	if len(fields1) != len(fields2) {
+		return RulePreRelease, fmt.Sprintf("pre-release %s has %s identifiers than %s", v.PreRelease, fewerOrMore(len(fields1) < len(fields2)), w.PreRelease)
+	}
+	return RuleEqual, fmt.Sprintf("it has the same precedence as %s", w)
+}
+
+func lessOrGreater(less bool) string {
+	if less {
+		return "less than"
+	}
+	return "greater than"
+}
+
+func beforeOrAfter(before bool) string {
+	if before {
+		return "before"
+	}
+	return "after"
+}
+
+func fewerOrMore(fewer bool) string {
+	if fewer {
+		return "fewer"
+	}
+	return "more"
+}
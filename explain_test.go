@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for Constraint.Explain
+func TestExplain(t *testing.T) {
+	testCases := []struct {
+		constraint string
+		version    string
+		satisfied  bool
+		set        int
+		rule       semver.Rule
+		reason     string
+	}{
+		{
+			constraint: "^1.2.3 || >=2.1.0 <3",
+			version:    "2.5.0",
+			satisfied:  true,
+			set:        1,
+			rule:       semver.RuleSatisfied,
+			reason:     "2.5.0 satisfies >=2.1.0 <3.0.0-0",
+		},
+		{
+			constraint: "^1.2.3 || >=2.1.0 <3",
+			version:    "2.0.5",
+			set:        0,
+			rule:       semver.RulePatch,
+			reason:     "2.0.5 does not satisfy <2.0.0-0: patch version 5 is greater than 0",
+		},
+		{
+			constraint: "~1.2.3",
+			version:    "1.4.0",
+			set:        0,
+			rule:       semver.RuleMinor,
+			reason:     "1.4.0 does not satisfy <1.3.0-0: minor version 4 is greater than 3",
+		},
+		{
+			constraint: "^1.5.0-rc.1",
+			version:    "1.6.0-beta",
+			set:        0,
+			rule:       semver.RulePreReleaseExcluded,
+			reason:     "1.6.0-beta is a pre-release and the range only admits pre-releases of 1.5.0",
+		},
+		{
+			constraint: "^1.2.3",
+			version:    "1.4.0-beta",
+			set:        0,
+			rule:       semver.RulePreReleaseExcluded,
+			reason:     "1.4.0-beta is a pre-release and the range admits no pre-releases",
+		},
+		{
+			constraint: ">=1.5.0-rc.1",
+			version:    "1.5.0-beta.2",
+			set:        0,
+			rule:       semver.RulePreRelease,
+			reason:     `1.5.0-beta.2 does not satisfy >=1.5.0-rc.1: pre-release identifier "beta" sorts before "rc" in ASCII order`,
+		},
+		{
+			constraint: ">=1.5.0-rc.10",
+			version:    "1.5.0-rc.9",
+			set:        0,
+			rule:       semver.RulePreRelease,
+			reason:     "1.5.0-rc.9 does not satisfy >=1.5.0-rc.10: pre-release identifier 9 is numerically less than 10",
+		},
+		{
+			constraint: "<1.5.0",
+			version:    "1.5.0+build.7",
+			set:        0,
+			rule:       semver.RuleEqual,
+			reason:     "1.5.0+build.7 does not satisfy <1.5.0: it has the same precedence as 1.5.0",
+		},
+		{
+			constraint: "<=1.5.0-rc.1",
+			version:    "1.5.0",
+			set:        0,
+			rule:       semver.RuleRelease,
+			reason:     "1.5.0 does not satisfy <=1.5.0-rc.1: a release has higher precedence than its pre-release 1.5.0-rc.1",
+		},
+		{
+			constraint: "1.x || >=3.0.0 <3.1.0 || 4.x",
+			version:    "3.2.0",
+			set:        1,
+			rule:       semver.RuleMinor,
+			reason:     "3.2.0 does not satisfy <3.1.0: minor version 2 is greater than 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.constraint+" "+tc.version, func(t *testing.T) {
+			c := semver.MustParseConstraint(tc.constraint)
+			v := semver.MustParse(tc.version)
+			e := c.Explain(v)
+			if e.Satisfied != tc.satisfied || e.Satisfied != c.Check(v) {
+				t.Errorf("Unexpected satisfied. expected: %v, actual: %v", tc.satisfied, e.Satisfied)
+			}
+			if e.Set != tc.set {
+				t.Errorf("Unexpected set. expected: %d, actual: %d", tc.set, e.Set)
+			}
+			if e.Rule != tc.rule {
+				t.Errorf("Unexpected rule. expected: %v, actual: %v", tc.rule, e.Rule)
+			}
+			if e.Reason != tc.reason {
+				t.Errorf("Unexpected reason.\nexpected: %s\nactual:   %s", tc.reason, e.Reason)
+			}
+		})
+	}
+}
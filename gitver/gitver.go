@@ -0,0 +1,449 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+// Package gitver reads the release history of a local Git repository from
+// its version tags. It reads .git/refs/tags and .git/packed-refs directly,
+// so no git binary is needed.
+//
+// A tag names a version when its name, with an optional leading "v", is a
+// valid semantic version, as in "v1.2.3" or "1.2.3-rc.1". Other tags are
+// reported as skipped rather than causing an error. In a repository that
+// tags several modules, as in "services/api/v1.4.0", ModuleHistory reads
+// the tags of one module.
+//
+// Example:
+//
+//	history, err := gitver.Read(".")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if history.HeadTagged() {
+//		fmt.Println("HEAD is already released as", history.HeadTags[0].Name)
+//	} else if latest := history.LatestRelease; latest != nil {
+//		fmt.Println("latest release is", latest.Version)
+//	}
+package gitver
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/maloquacious/semver"
+)
+
+// Tag is a tag that names a semantic version.
+type Tag struct {
+	Name    string         // the tag name without "refs/tags/", such as "v1.2.3"
+	Version semver.Version // the version the name stands for
+	Object  string         // the object the tag ref points to; a tag object for an annotated tag
+	Commit  string         // the commit the tag points to, after peeling annotated tags; "" if unknown
+}
+
+// Skipped is a tag whose name is not a semantic version.
+type Skipped struct {
+	Name string // the tag name without "refs/tags/"
+	Err  error  // why the name was rejected, usually a *semver.ParseError
+}
+
+// History is the release history recorded in a repository's tags.
+type History struct {
+	Tags             []Tag     // the version tags, in order of precedence, lowest first
+	Skipped          []Skipped // the tags that are not versions, by name
+	LatestRelease    *Tag      // the highest tag without a pre-release, or nil if there is none
+	LatestPreRelease *Tag      // the highest pre-release tag, or nil; it may be lower than LatestRelease
+	Head             string    // the commit of HEAD, or "" in a repository without commits
+	HeadTags         []Tag     // the version tags that point at HEAD, in order of precedence
+	Unresolved       []Tag     // the version tags whose commit is unknown, in order of precedence
+}
+
+// HeadTagged reports whether HEAD already has a version tag.
+// A tag in Unresolved may also point at HEAD; when it matters, resolve
+// those tags with "git rev-parse" or check Unresolved is empty.
+func (h History) HeadTagged() bool {
+	return len(h.HeadTags) != 0
+}
+
+// Latest returns the highest version tag, release or pre-release,
+// or nil if there are none.
+func (h History) Latest() *Tag {
+	if len(h.Tags) == 0 {
+		return nil
+	}
+	return &h.Tags[len(h.Tags)-1]
+}
+
+// Next returns the version that follows the highest tag, as computed by
+// semver.Version.Bump, or that follows 0.0.0 when there are no tags.
+// Because Bump releases a pre-release instead of skipping it, Next with
+// semver.BumpMinor after v1.3.0-rc.2 is 1.3.0.
+func (h History) Next(kind semver.BumpKind, opts semver.BumpOptions) (semver.Version, error) {
+	var base semver.Version
+	if latest := h.Latest(); latest != nil {
+		base = latest.Version
+	}
+	return base.Bump(kind, opts)
+}
+
+// Repository is a Git repository whose refs are read from the file system.
+type Repository struct {
+	gitDir    fs.FS // the directory holding HEAD
+	commonDir fs.FS // the directory holding refs, packed-refs and objects
+}
+
+// Open opens the repository at dir, which is a work tree containing a .git
+// directory or file, or the Git directory itself, as in a bare repository.
+// A .git file, as used by linked work trees and submodules, is followed to
+// the Git directory it names.
+func Open(dir string) (*Repository, error) {
+	gitDir := filepath.Join(dir, ".git")
+	info, err := os.Stat(gitDir)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		// a bare repository, or a path to the Git directory itself
+		if _, err := os.Stat(filepath.Join(dir, "HEAD")); err != nil {
+			return nil, fmt.Errorf("gitver: %s is not a git repository", dir)
+		}
+		gitDir = dir
+	case err != nil:
+		return nil, fmt.Errorf("gitver: %w", err)
+	case !info.IsDir():
+		data, err := os.ReadFile(gitDir)
+		if err != nil {
+			return nil, fmt.Errorf("gitver: %w", err)
+		}
+		target, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir: ")
+		if !ok {
+			return nil, fmt.Errorf("gitver: %s: missing gitdir", gitDir)
+		}
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(dir, target)
+		}
+		gitDir = target
+	}
+
+	// a linked work tree keeps its own HEAD but shares the refs of the main repository
+	commonDir := gitDir
+	if data, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		commonDir = strings.TrimSpace(string(data))
+		if !filepath.IsAbs(commonDir) {
+			commonDir = filepath.Join(gitDir, commonDir)
+		}
+	}
+	return &Repository{gitDir: os.DirFS(gitDir), commonDir: os.DirFS(commonDir)}, nil
+}
+
+// OpenFS returns the repository whose Git directory is fsys. It is useful
+// for reading a repository from an archive or a test fixture.
+func OpenFS(fsys fs.FS) *Repository {
+	return &Repository{gitDir: fsys, commonDir: fsys}
+}
+
+// Read opens the repository at dir and returns its history.
+func Read(dir string) (History, error) {
+	r, err := Open(dir)
+	if err != nil {
+		return History{}, err
+	}
+	return r.History()
+}
+
+// History reads the tags and HEAD of the repository.
+func (r *Repository) History() (History, error) {
+	return r.history("")
+}
+
+// ModuleHistory is History for the module whose tags start with prefix and
+// a slash, as in "services/api/v1.4.0" for the prefix "services/api". The
+// rest of the name must be a version, with an optional leading "v", to be
+// a version tag; tags outside the prefix, including those of modules in
+// subdirectories of it, are neither tags nor skipped. Use
+// semver.ModuleTagPrefix to find the prefix of a Go module.
+func (r *Repository) ModuleHistory(prefix string) (History, error) {
+	if prefix = strings.Trim(prefix, "/"); prefix == "" {
+		return History{}, fmt.Errorf("gitver: empty module prefix")
+	}
+	return r.history(prefix + "/")
+}
+
+// history reads HEAD and the tags whose names start with prefix.
+func (r *Repository) history(prefix string) (History, error) {
+	var h History
+	var err error
+	if h.Tags, h.Skipped, err = r.tags(prefix); err != nil {
+		return History{}, err
+	}
+	if h.Head, err = r.Head(); err != nil {
+		return History{}, err
+	}
+	for i, tag := range h.Tags {
+		if tag.Version.PreRelease == "" {
+			h.LatestRelease = &h.Tags[i]
+		} else {
+			h.LatestPreRelease = &h.Tags[i]
+		}
+		if tag.Commit == "" && tag.Object == h.Head {
+			// the object is HEAD's commit, so the tag is not annotated
+			h.Tags[i].Commit = tag.Object
+			tag = h.Tags[i]
+		}
+		if tag.Commit == "" {
+			h.Unresolved = append(h.Unresolved, tag)
+		} else if h.Head != "" && tag.Commit == h.Head {
+			h.HeadTags = append(h.HeadTags, tag)
+		}
+	}
+	return h, nil
+}
+
+// Head returns the commit that HEAD points at, following a symbolic ref
+// such as "ref: refs/heads/main". It returns "" if the branch has no commits yet.
+func (r *Repository) Head() (string, error) {
+	data, err := fs.ReadFile(r.gitDir, "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("gitver: reading HEAD: %w", err)
+	}
+	head := strings.TrimSpace(string(data))
+	for depth := 0; depth < 10; depth++ {
+		name, ok := strings.CutPrefix(head, "ref: ")
+		if !ok {
+			if !isHash(head) {
+				return "", fmt.Errorf("gitver: invalid HEAD %q", head)
+			}
+			return head, nil
+		}
+		if head, err = r.resolveRef(name); err != nil {
+			return "", err
+		} else if head == "" {
+			return "", nil
+		}
+	}
+	return "", fmt.Errorf("gitver: too many levels of symbolic refs in HEAD")
+}
+
+// resolveRef returns the contents of a loose ref or the hash of a packed ref,
+// or "" if the ref does not exist.
+func (r *Repository) resolveRef(name string) (string, error) {
+	if data, err := fs.ReadFile(r.commonDir, name); err == nil {
+		return strings.TrimSpace(string(data)), nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("gitver: reading %s: %w", name, err)
+	}
+	packed, err := r.packedRefs()
+	if err != nil {
+		return "", err
+	}
+	return packed[name].hash, nil
+}
+
+// packedRef is a ref from packed-refs.
+type packedRef struct {
+	hash   string
+	peeled string // the commit of an annotated tag, from a "^" line
+}
+
+// packedRefs reads packed-refs. A missing file has no refs.
+// When the header promises that tags are peeled, as Git has written since
+// 2013, a tag without a "^" line is known to point at its commit.
+func (r *Repository) packedRefs() (map[string]packedRef, error) {
+	refs := make(map[string]packedRef)
+	f, err := r.commonDir.Open("packed-refs")
+	if errors.Is(err, fs.ErrNotExist) {
+		return refs, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("gitver: %w", err)
+	}
+	defer f.Close()
+
+	var last string
+	var peeledTags, fullyPeeled bool
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if traits, ok := strings.CutPrefix(line, "# pack-refs with:"); ok && n == 1 {
+			for _, trait := range strings.Fields(traits) {
+				peeledTags = peeledTags || trait == "peeled" || trait == "fully-peeled"
+				fullyPeeled = fullyPeeled || trait == "fully-peeled"
+			}
+		}
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		if peeled, ok := strings.CutPrefix(line, "^"); ok {
+			if last == "" || !isHash(peeled) {
+				return nil, fmt.Errorf("gitver: packed-refs line %d: invalid peeled ref", n)
+			}
+			ref := refs[last]
+			ref.peeled = peeled
+			refs[last] = ref
+			continue
+		}
+		hash, name, ok := strings.Cut(line, " ")
+		if !ok || !isHash(hash) {
+			return nil, fmt.Errorf("gitver: packed-refs line %d: invalid ref", n)
+		}
+		refs[name], last = packedRef{hash: hash}, name
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("gitver: reading packed-refs: %w", err)
+	}
+	for name, ref := range refs {
+		if ref.peeled == "" && (fullyPeeled || (peeledTags && strings.HasPrefix(name, "refs/tags/"))) {
+			ref.peeled = ref.hash
+			refs[name] = ref
+		}
+	}
+	return refs, nil
+}
+
+// Tags returns the tags that name versions, in order of precedence, and
+// the tags that don't, by name. Versions with the same precedence, such
+// as "v1.0.0" and "1.0.0", are ordered by name. Loose refs take precedence
+// over packed-refs, as they do in Git.
+//
+// Annotated tags are peeled to their commit using the peeled lines of
+// packed-refs or the loose tag object. Reading pack files is beyond this
+// package, so a loose ref whose object is not stored loose may be an
+// annotated tag; its Commit is left empty rather than guessed.
+func (r *Repository) Tags() ([]Tag, []Skipped, error) {
+	return r.tags("")
+}
+
+// tags returns the tags whose names start with prefix, parsing the rest of
+// the name as the version. Unless prefix is "", names with another slash
+// after the prefix are left out.
+func (r *Repository) tags(prefix string) ([]Tag, []Skipped, error) {
+	packed, err := r.packedRefs()
+	if err != nil {
+		return nil, nil, err
+	}
+	refs := make(map[string]packedRef)
+	for name, ref := range packed {
+		if tag, ok := strings.CutPrefix(name, "refs/tags/"); ok {
+			refs[tag] = ref
+		}
+	}
+	err = fs.WalkDir(r.commonDir, "refs/tags", func(name string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) && name == "refs/tags" {
+			return fs.SkipDir
+		} else if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := fs.ReadFile(r.commonDir, name)
+		if err != nil {
+			return err
+		}
+		hash := strings.TrimSpace(string(data))
+		if !isHash(hash) {
+			return fmt.Errorf("%s: invalid ref %q", name, hash)
+		}
+		refs[strings.TrimPrefix(name, "refs/tags/")] = packedRef{hash: hash}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("gitver: reading tags: %w", err)
+	}
+
+	names := make([]string, 0, len(refs))
+	for name := range refs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var tags []Tag
+	var skipped []Skipped
+	for _, name := range names {
+		rest, ok := strings.CutPrefix(name, prefix)
+		if !ok || (prefix != "" && strings.Contains(rest, "/")) {
+			continue
+		}
+		v, err := semver.Parse(strings.TrimPrefix(rest, "v"))
+		if err != nil {
+			skipped = append(skipped, Skipped{Name: name, Err: err})
+			continue
+		}
+		ref := refs[name]
+		tag := Tag{Name: name, Version: v, Object: ref.hash, Commit: ref.peeled}
+		if tag.Commit == "" {
+			if tag.Commit, err = r.peel(ref.hash); err != nil {
+				return nil, nil, err
+			}
+		}
+		tags = append(tags, tag)
+	}
+	slices.SortStableFunc(tags, func(a, b Tag) int {
+		return a.Version.Compare(b.Version)
+	})
+	return tags, skipped, nil
+}
+
+// peel follows annotated tag objects stored loose until it reaches another
+// kind of object, which it returns. It returns "" when it reaches an object
+// that is not stored loose, whose kind is unknown.
+func (r *Repository) peel(hash string) (string, error) {
+	for depth := 0; depth < 10; depth++ {
+		kind, body, err := r.readObject(hash)
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		} else if err != nil {
+			return "", err
+		} else if kind != "tag" {
+			return hash, nil
+		}
+		header := strings.Split(string(body), "\n")
+		next, ok := strings.CutPrefix(header[0], "object ")
+		if !ok || !isHash(next) {
+			return "", fmt.Errorf("gitver: tag object %s: missing object", hash)
+		}
+		if len(header) > 1 && header[1] == "type commit" {
+			// the tag names the kind of its target, so it needn't be read
+			return next, nil
+		}
+		hash = next
+	}
+	return "", fmt.Errorf("gitver: too many levels of tags at %s", hash)
+}
+
+// readObject reads a loose object and returns its type and contents.
+func (r *Repository) readObject(hash string) (string, []byte, error) {
+	f, err := r.commonDir.Open(path.Join("objects", hash[:2], hash[2:]))
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+	zr, err := zlib.NewReader(f)
+	if err != nil {
+		return "", nil, fmt.Errorf("gitver: object %s: %w", hash, err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return "", nil, fmt.Errorf("gitver: object %s: %w", hash, err)
+	}
+	header, body, ok := bytes.Cut(data, []byte{0})
+	kind, _, _ := strings.Cut(string(header), " ")
+	if !ok || kind == "" {
+		return "", nil, fmt.Errorf("gitver: object %s: invalid header", hash)
+	}
+	return kind, body, nil
+}
+
+// isHash reports whether s is a SHA-1 or SHA-256 object name in lower case hex.
+func isHash(s string) bool {
+	if len(s) != 40 && len(s) != 64 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !('0' <= s[i] && s[i] <= '9') && !('a' <= s[i] && s[i] <= 'f') {
+			return false
+		}
+	}
+	return true
+}
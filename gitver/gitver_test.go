@@ -0,0 +1,298 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package gitver_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/maloquacious/semver"
+	"github.com/maloquacious/semver/gitver"
+)
+
+const (
+	commit1 = "1111111111111111111111111111111111111111"
+	commit2 = "2222222222222222222222222222222222222222"
+	commit3 = "3333333333333333333333333333333333333333"
+	tree    = "4444444444444444444444444444444444444444"
+	tagObj1 = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	tagObj2 = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	tagObj3 = "cccccccccccccccccccccccccccccccccccccccc"
+)
+
+// looseObject returns a loose object file, compressed the way Git stores it.
+func looseObject(kind, body string) *fstest.MapFile {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	fmt.Fprintf(zw, "%s %d\x00%s", kind, len(body), body)
+	zw.Close()
+	return &fstest.MapFile{Data: buf.Bytes()}
+}
+
+// objectPath returns the path of a loose object.
+func objectPath(hash string) string {
+	return "objects/" + hash[:2] + "/" + hash[2:]
+}
+
+// repository returns a Git directory with packed and loose tags:
+//
+//	commit1: v0.9.0 (packed), 1.0.0-rc.1 (packed, annotated)
+//	commit2: v1.0.0 (loose), v1.1.0-beta.1 (loose, annotated); stored loose
+//	commit3: HEAD via refs/heads/main, release-2024 (not a version)
+func repository() fstest.MapFS {
+	return fstest.MapFS{
+		"HEAD": {Data: []byte("ref: refs/heads/main\n")},
+		"packed-refs": {Data: []byte("# pack-refs with: peeled fully-peeled sorted \n" +
+			commit3 + " refs/heads/main\n" +
+			tagObj1 + " refs/tags/1.0.0-rc.1\n" +
+			"^" + commit1 + "\n" +
+			commit1 + " refs/tags/v0.9.0\n" +
+			commit1 + " refs/tags/v1.0.0\n")},
+		"refs/tags/v1.0.0":        {Data: []byte(commit2 + "\n")},
+		"refs/tags/v1.1.0-beta.1": {Data: []byte(tagObj2 + "\n")},
+		"refs/tags/release-2024":  {Data: []byte(commit3 + "\n")},
+		objectPath(tagObj2):       looseObject("tag", "object "+commit2+"\ntype commit\ntag v1.1.0-beta.1\n\nbeta\n"),
+		objectPath(commit2):       looseObject("commit", "tree "+tree+"\n\nrelease 1.0.0\n"),
+	}
+}
+
+// Test for Repository.History
+func TestHistory(t *testing.T) {
+	h, err := gitver.OpenFS(repository()).History()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []struct{ name, version, object, commit string }{
+		{"v0.9.0", "0.9.0", commit1, commit1},
+		{"1.0.0-rc.1", "1.0.0-rc.1", tagObj1, commit1},
+		{"v1.0.0", "1.0.0", commit2, commit2}, // the loose ref overrides the packed one
+		{"v1.1.0-beta.1", "1.1.0-beta.1", tagObj2, commit2},
+	}
+	if len(h.Tags) != len(expected) {
+		t.Fatalf("Expected %d tags, actual %v", len(expected), h.Tags)
+	}
+	for i, e := range expected {
+		tag := h.Tags[i]
+		if tag.Name != e.name || tag.Version.String() != e.version || tag.Object != e.object || tag.Commit != e.commit {
+			t.Errorf("Tag %d: expected %v, actual %+v", i, e, tag)
+		}
+	}
+
+	if len(h.Skipped) != 1 || h.Skipped[0].Name != "release-2024" || h.Skipped[0].Err == nil {
+		t.Errorf("Expected release-2024 to be skipped with an error, actual %+v", h.Skipped)
+	}
+	if h.LatestRelease == nil || h.LatestRelease.Name != "v1.0.0" {
+		t.Errorf("LatestRelease: expected v1.0.0, actual %+v", h.LatestRelease)
+	}
+	if h.LatestPreRelease == nil || h.LatestPreRelease.Name != "v1.1.0-beta.1" {
+		t.Errorf("LatestPreRelease: expected v1.1.0-beta.1, actual %+v", h.LatestPreRelease)
+	}
+	if latest := h.Latest(); latest == nil || latest.Name != "v1.1.0-beta.1" {
+		t.Errorf("Latest: expected v1.1.0-beta.1, actual %+v", latest)
+	}
+	if h.Unresolved != nil {
+		t.Errorf("Expected every tag to be resolved, actual %+v", h.Unresolved)
+	}
+	if h.Head != commit3 || h.HeadTagged() {
+		t.Errorf("Expected untagged HEAD %s, actual %s with tags %v", commit3, h.Head, h.HeadTags)
+	}
+
+	for _, tc := range []struct {
+		kind     semver.BumpKind
+		expected string
+	}{
+		{semver.BumpMinor, "1.1.0"},
+		{semver.BumpPreRelease, "1.1.0-beta.2"},
+		{semver.BumpMajor, "2.0.0"},
+	} {
+		if next, err := h.Next(tc.kind, semver.BumpOptions{}); err != nil || next.String() != tc.expected {
+			t.Errorf("Next(%s): expected %s, actual %s (%v)", tc.kind, tc.expected, next, err)
+		}
+	}
+}
+
+// Test that HEAD is recognized as tagged, directly or through an annotated tag
+func TestHeadTagged(t *testing.T) {
+	for _, head := range []string{"ref: refs/heads/main\n", commit2 + "\n"} {
+		repo := repository()
+		repo["HEAD"] = &fstest.MapFile{Data: []byte(head)}
+		repo["refs/heads/main"] = &fstest.MapFile{Data: []byte(commit2 + "\n")}
+		h, err := gitver.OpenFS(repo).History()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !h.HeadTagged() || len(h.HeadTags) != 2 || h.HeadTags[0].Name != "v1.0.0" || h.HeadTags[1].Name != "v1.1.0-beta.1" {
+			t.Errorf("HEAD %q: expected tags v1.0.0 and v1.1.0-beta.1, actual %+v", head, h.HeadTags)
+		}
+	}
+}
+
+// Test for Repository.ModuleHistory in a repository that tags several modules
+func TestModuleHistory(t *testing.T) {
+	repo := repository()
+	repo["refs/tags/services/api/v1.4.0"] = &fstest.MapFile{Data: []byte(commit2 + "\n")}
+	repo["refs/tags/services/api/v1.5.0-rc.1"] = &fstest.MapFile{Data: []byte(commit3 + "\n")}
+	repo["refs/tags/services/api/latest"] = &fstest.MapFile{Data: []byte(commit3 + "\n")}
+	repo["refs/tags/services/api/v2/v2.0.0"] = &fstest.MapFile{Data: []byte(commit3 + "\n")}
+	repo["refs/tags/services/web/v0.1.0"] = &fstest.MapFile{Data: []byte(commit3 + "\n")}
+	r := gitver.OpenFS(repo)
+
+	for _, prefix := range []string{"services/api", "services/api/"} {
+		h, err := r.ModuleHistory(prefix)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(h.Tags) != 2 || h.Tags[0].Name != "services/api/v1.4.0" || h.Tags[1].Version.String() != "1.5.0-rc.1" {
+			t.Errorf("%q: expected services/api/v1.4.0 and v1.5.0-rc.1, actual %+v", prefix, h.Tags)
+		}
+		if len(h.Skipped) != 1 || h.Skipped[0].Name != "services/api/latest" {
+			t.Errorf("%q: expected services/api/latest to be skipped, actual %+v", prefix, h.Skipped)
+		}
+		if !h.HeadTagged() || h.HeadTags[0].Name != "services/api/v1.5.0-rc.1" || h.LatestRelease.Name != "services/api/v1.4.0" {
+			t.Errorf("%q: unexpected history %+v", prefix, h)
+		}
+		if next, err := h.Next(semver.BumpPatch, semver.BumpOptions{}); err != nil || next.String() != "1.5.0" {
+			t.Errorf("%q: Next(patch): expected 1.5.0, actual %s (%v)", prefix, next, err)
+		}
+	}
+
+	// the root history is unchanged, with the module tags skipped
+	h, err := r.History()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(h.Tags) != 4 || len(h.Skipped) != 6 {
+		t.Errorf("Expected 4 tags and 6 skipped, actual %d and %d", len(h.Tags), len(h.Skipped))
+	}
+
+	if _, err := r.ModuleHistory("/"); err == nil {
+		t.Errorf("Expected an error for an empty prefix")
+	}
+}
+
+// Test that tags whose objects are packed are reported rather than guessed
+func TestUnresolvedTags(t *testing.T) {
+	repo := repository()
+	// the objects of v1.2.0 and v1.3.0 are in pack files: either could be a tag object
+	repo["refs/tags/v1.2.0"] = &fstest.MapFile{Data: []byte(tagObj3 + "\n")}
+	repo["refs/tags/v1.3.0"] = &fstest.MapFile{Data: []byte(commit3 + "\n")}
+	// without the peeled trait, the packed v0.9.0 may be annotated too
+	repo["packed-refs"] = &fstest.MapFile{Data: []byte(commit3 + " refs/heads/main\n" + commit1 + " refs/tags/v0.9.0\n")}
+	h, err := gitver.OpenFS(repo).History()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{"v0.9.0", "v1.2.0"}
+	if len(h.Unresolved) != len(expected) {
+		t.Fatalf("Expected unresolved %v, actual %+v", expected, h.Unresolved)
+	}
+	for i, name := range expected {
+		if tag := h.Unresolved[i]; tag.Name != name || tag.Commit != "" {
+			t.Errorf("Unresolved %d: expected %s without a commit, actual %+v", i, name, tag)
+		}
+	}
+	// v1.3.0 points at HEAD's commit, so it can't be a tag object
+	if !h.HeadTagged() || len(h.HeadTags) != 1 || h.HeadTags[0].Name != "v1.3.0" || h.HeadTags[0].Commit != commit3 {
+		t.Errorf("Expected HEAD to be tagged v1.3.0, actual %+v", h.HeadTags)
+	}
+	if latest := h.Latest(); latest == nil || latest.Name != "v1.3.0" {
+		t.Errorf("Latest: expected v1.3.0, actual %+v", latest)
+	}
+}
+
+// Test for a repository without commits or tags
+func TestEmptyRepository(t *testing.T) {
+	h, err := gitver.OpenFS(fstest.MapFS{"HEAD": {Data: []byte("ref: refs/heads/main\n")}}).History()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if h.Head != "" || h.Tags != nil || h.LatestRelease != nil || h.LatestPreRelease != nil || h.HeadTagged() {
+		t.Errorf("Expected an empty history, actual %+v", h)
+	}
+	if next, err := h.Next(semver.BumpMinor, semver.BumpOptions{}); err != nil || next.String() != "0.1.0" {
+		t.Errorf("Next(minor): expected 0.1.0, actual %s (%v)", next, err)
+	}
+}
+
+// Test for malformed repositories
+func TestHistoryErrors(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		name  string
+		data  string
+		error string
+	}{
+		{"invalid loose ref", "refs/tags/v2.0.0", "not-a-hash\n", "invalid ref"},
+		{"invalid packed ref", "packed-refs", "xyz refs/tags/v2.0.0\n", "packed-refs line 1: invalid ref"},
+		{"orphan peeled line", "packed-refs", "^" + commit1 + "\n", "packed-refs line 1: invalid peeled ref"},
+		{"invalid HEAD", "HEAD", "garbage\n", "invalid HEAD"},
+		{"corrupt tag object", objectPath(tagObj2), "not zlib", "object " + tagObj2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repo := repository()
+			repo[tc.name] = &fstest.MapFile{Data: []byte(tc.data)}
+			_, err := gitver.OpenFS(repo).History()
+			if err == nil || !strings.Contains(err.Error(), tc.error) {
+				t.Errorf("Expected error containing %q, actual %v", tc.error, err)
+			}
+		})
+	}
+}
+
+// Test that Open finds the Git directory of a work tree, a linked work tree and a bare repository
+func TestOpen(t *testing.T) {
+	root := t.TempDir()
+	write := func(name, data string) {
+		t.Helper()
+		name = filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the main work tree is on main, tagged v1.0.0
+	write("main/.git/HEAD", "ref: refs/heads/main\n")
+	write("main/.git/refs/heads/main", commit1+"\n")
+	write("main/.git/refs/tags/v1.0.0", commit1+"\n")
+	// the linked work tree is detached at an untagged commit
+	write("main/.git/worktrees/feature/HEAD", commit2+"\n")
+	write("main/.git/worktrees/feature/commondir", "../..\n")
+	write("feature/.git", "gitdir: ../main/.git/worktrees/feature\n")
+
+	testCases := []struct {
+		dir    string
+		head   string
+		tagged bool
+	}{
+		{"main", commit1, true},
+		{"main/.git", commit1, true},
+		{"feature", commit2, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.dir, func(t *testing.T) {
+			h, err := gitver.Read(filepath.Join(root, tc.dir))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if h.Head != tc.head || h.HeadTagged() != tc.tagged || len(h.Tags) != 1 {
+				t.Errorf("Expected HEAD %s, tagged %v and one tag, actual %s, %v and %v", tc.head, tc.tagged, h.Head, h.HeadTagged(), h.Tags)
+			}
+		})
+	}
+
+	if _, err := gitver.Open(root); err == nil || !strings.Contains(err.Error(), "not a git repository") {
+		t.Errorf("Expected an error for a directory that is not a repository, actual %v", err)
+	}
+}
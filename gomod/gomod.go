@@ -0,0 +1,166 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+// Package gomod implements the "v"-prefixed version strings used by Go modules,
+// with the same semantics as golang.org/x/mod/semver, on top of semver.BigVersion,
+// so that, like x/mod, it accepts version numbers of any size.
+//
+// A valid version starts with "v", as in "v1.2.3". The shorthands "v1" and "v1.2"
+// are accepted for "v1.0.0" and "v1.2.0", but only without pre-release or build
+// metadata. Functions that return a version return "" for invalid input.
+package gomod
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/maloquacious/semver"
+)
+
+// parse returns the version that v stands for, expanding the shorthands.
+func parse(v string) (semver.BigVersion, bool) {
+	if v == "" || v[0] != 'v' {
+		return semver.BigVersion{}, false
+	}
+	s := v[1:]
+	core, tail := s, ""
+	if i := strings.IndexAny(s, "-+"); i != -1 {
+		core, tail = s[:i], s[i:]
+	}
+	switch strings.Count(core, ".") {
+	case 0:
+		if tail != "" {
+			return semver.BigVersion{}, false
+		}
+		core += ".0.0"
+	case 1:
+		if tail != "" {
+			return semver.BigVersion{}, false
+		}
+		core += ".0"
+	}
+	sv, err := semver.ParseBig(core + tail)
+	return sv, err == nil
+}
+
+// IsValid reports whether v is a valid semantic version string.
+func IsValid(v string) bool {
+	_, ok := parse(v)
+	return ok
+}
+
+// Canonical returns the canonical formatting of the semantic version v.
+// It fills in any missing .MINOR or .PATCH and discards build metadata.
+// Two semantic versions compare equal only if their canonical formattings
+// are identical strings. The canonical invalid semantic version is "".
+func Canonical(v string) string {
+	sv, ok := parse(v)
+	if !ok {
+		return ""
+	}
+	return "v" + sv.Short()
+}
+
+// CanonicalVersion is Canonical, except that the build suffix
+// "+incompatible" is kept, as in golang.org/x/mod/module.CanonicalVersion.
+// The go command uses it to mark modules without a go.mod file at major
+// version 2 or later, so it is part of the version of such a module.
+func CanonicalVersion(v string) string {
+	cv := Canonical(v)
+	if cv != "" && Build(v) == "+incompatible" {
+		cv += "+incompatible"
+	}
+	return cv
+}
+
+// Major returns the major version prefix of the semantic version v.
+// For example, Major("v2.1.0") == "v2".
+// If v is an invalid semantic version string, Major returns the empty string.
+func Major(v string) string {
+	sv, ok := parse(v)
+	if !ok {
+		return ""
+	}
+	return "v" + sv.Major
+}
+
+// MajorMinor returns the major.minor version prefix of the semantic version v.
+// For example, MajorMinor("v2.1.0") == "v2.1".
+// If v is an invalid semantic version string, MajorMinor returns the empty string.
+func MajorMinor(v string) string {
+	sv, ok := parse(v)
+	if !ok {
+		return ""
+	}
+	return "v" + sv.Major + "." + sv.Minor
+}
+
+// Prerelease returns the prerelease suffix of the semantic version v.
+// For example, Prerelease("v2.1.0-pre+meta") == "-pre".
+// If v is an invalid semantic version string, Prerelease returns the empty string.
+func Prerelease(v string) string {
+	sv, ok := parse(v)
+	if !ok || sv.PreRelease == "" {
+		return ""
+	}
+	return "-" + sv.PreRelease
+}
+
+// Build returns the build suffix of the semantic version v.
+// For example, Build("v2.1.0+meta") == "+meta".
+// If v is an invalid semantic version string, Build returns the empty string.
+func Build(v string) string {
+	sv, ok := parse(v)
+	if !ok || sv.Build == "" {
+		return ""
+	}
+	return "+" + sv.Build
+}
+
+// Compare returns an integer comparing two versions according to
+// semantic version precedence, using semver.BigVersion.Compare.
+// The result will be 0 if v == w, -1 if v < w, or +1 if v > w.
+//
+// An invalid semantic version string is considered less than a valid one.
+// All invalid semantic version strings compare equal to each other.
+func Compare(v, w string) int {
+	sv, ok1 := parse(v)
+	sw, ok2 := parse(w)
+	switch {
+	case !ok1 && !ok2:
+		return 0
+	case !ok1:
+		return -1
+	case !ok2:
+		return 1
+	}
+	return sv.Compare(sw)
+}
+
+// Max canonicalizes its arguments and then returns the version string
+// that compares greater. If both are invalid, it returns "".
+func Max(v, w string) string {
+	v = Canonical(v)
+	w = Canonical(w)
+	if Compare(v, w) > 0 {
+		return v
+	}
+	return w
+}
+
+// ByVersion implements sort.Interface for sorting semantic version strings.
+type ByVersion []string
+
+func (vs ByVersion) Len() int      { return len(vs) }
+func (vs ByVersion) Swap(i, j int) { vs[i], vs[j] = vs[j], vs[i] }
+func (vs ByVersion) Less(i, j int) bool {
+	cmp := Compare(vs[i], vs[j])
+	if cmp != 0 {
+		return cmp < 0
+	}
+	return vs[i] < vs[j]
+}
+
+// Sort sorts a list of semantic version strings using ByVersion.
+func Sort(list []string) {
+	sort.Sort(ByVersion(list))
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package gomod_test
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/maloquacious/semver/gomod"
+)
+
+// tests are the cases from golang.org/x/mod/semver, in increasing order.
+var tests = []struct {
+	in  string
+	out string
+}{
+	{"bad", ""},
+	{"v1-alpha.beta.gamma", ""},
+	{"v1-pre", ""},
+	{"v1+meta", ""},
+	{"v1-pre+meta", ""},
+	{"v1.2-pre", ""},
+	{"v1.2+meta", ""},
+	{"v1.2-pre+meta", ""},
+	{"v1.0.0-alpha", "v1.0.0-alpha"},
+	{"v1.0.0-alpha.1", "v1.0.0-alpha.1"},
+	{"v1.0.0-alpha.beta", "v1.0.0-alpha.beta"},
+	{"v1.0.0-beta", "v1.0.0-beta"},
+	{"v1.0.0-beta.2", "v1.0.0-beta.2"},
+	{"v1.0.0-beta.11", "v1.0.0-beta.11"},
+	{"v1.0.0-rc.1", "v1.0.0-rc.1"},
+	{"v1", "v1.0.0"},
+	{"v1.0", "v1.0.0"},
+	{"v1.0.0", "v1.0.0"},
+	{"v1.2", "v1.2.0"},
+	{"v1.2.0", "v1.2.0"},
+	{"v1.2.3-456", "v1.2.3-456"},
+	{"v1.2.3-456.789", "v1.2.3-456.789"},
+	{"v1.2.3-456-789", "v1.2.3-456-789"},
+	{"v1.2.3-456a", "v1.2.3-456a"},
+	{"v1.2.3-pre", "v1.2.3-pre"},
+	{"v1.2.3-pre+meta", "v1.2.3-pre"},
+	{"v1.2.3-pre.1", "v1.2.3-pre.1"},
+	{"v1.2.3-zzz", "v1.2.3-zzz"},
+	{"v1.2.3", "v1.2.3"},
+	{"v1.2.3+meta", "v1.2.3"},
+	{"v1.2.3+meta-pre", "v1.2.3"},
+	{"v1.2.3+meta-pre.sha.256a", "v1.2.3"},
+}
+
+func TestIsValid(t *testing.T) {
+	for _, tt := range tests {
+		ok := gomod.IsValid(tt.in)
+		if ok != (tt.out != "") {
+			t.Errorf("IsValid(%q) = %v, want %v", tt.in, ok, !ok)
+		}
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	for _, tt := range tests {
+		out := gomod.Canonical(tt.in)
+		if out != tt.out {
+			t.Errorf("Canonical(%q) = %q, want %q", tt.in, out, tt.out)
+		}
+	}
+}
+
+func TestCanonicalVersion(t *testing.T) {
+	for _, tt := range []struct{ in, canonical, out string }{
+		{"v2.0.0+incompatible", "v2.0.0", "v2.0.0+incompatible"},
+		{"v2.0.0-rc.1+incompatible", "v2.0.0-rc.1", "v2.0.0-rc.1+incompatible"},
+		{"v2.0.0+incompatible.1", "v2.0.0", "v2.0.0"},
+		{"v1.2", "v1.2.0", "v1.2.0"},
+		{"1.2.3", "", ""},
+		{"v01.2.3", "", ""},
+	} {
+		if out := gomod.Canonical(tt.in); out != tt.canonical {
+			t.Errorf("Canonical(%q) = %q, want %q", tt.in, out, tt.canonical)
+		}
+		if out := gomod.CanonicalVersion(tt.in); out != tt.out {
+			t.Errorf("CanonicalVersion(%q) = %q, want %q", tt.in, out, tt.out)
+		}
+	}
+}
+
+func TestMajor(t *testing.T) {
+	for _, tt := range tests {
+		out := gomod.Major(tt.in)
+		want := ""
+		if tt.out != "" {
+			want = tt.out[:len("v1")]
+		}
+		if out != want {
+			t.Errorf("Major(%q) = %q, want %q", tt.in, out, want)
+		}
+	}
+	if out := gomod.Major("v12.3.4"); out != "v12" {
+		t.Errorf("Major(%q) = %q, want %q", "v12.3.4", out, "v12")
+	}
+	if out := gomod.Major("v99999999999999999999.3.4"); out != "v99999999999999999999" {
+		t.Errorf("Major(%q) = %q, want %q", "v99999999999999999999.3.4", out, "v99999999999999999999")
+	}
+}
+
+func TestMajorMinor(t *testing.T) {
+	for _, tt := range tests {
+		out := gomod.MajorMinor(tt.in)
+		want := ""
+		if tt.out != "" {
+			want = tt.out[:len("v1.2")]
+		}
+		if out != want {
+			t.Errorf("MajorMinor(%q) = %q, want %q", tt.in, out, want)
+		}
+	}
+}
+
+func TestPrerelease(t *testing.T) {
+	for _, tt := range tests {
+		pre := gomod.Prerelease(tt.in)
+		want := ""
+		if tt.out != "" {
+			if i := len("v1.2.3"); len(tt.out) > i && tt.out[i] == '-' {
+				want = tt.out[i:]
+			}
+		}
+		if pre != want {
+			t.Errorf("Prerelease(%q) = %q, want %q", tt.in, pre, want)
+		}
+	}
+}
+
+func TestBuild(t *testing.T) {
+	for _, tt := range tests {
+		build := gomod.Build(tt.in)
+		want := ""
+		if i := strings.IndexByte(tt.in, '+'); tt.out != "" && i != -1 {
+			want = tt.in[i:]
+		}
+		if build != want {
+			t.Errorf("Build(%q) = %q, want %q", tt.in, build, want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	for i, ti := range tests {
+		for j, tj := range tests {
+			cmp := gomod.Compare(ti.in, tj.in)
+			var want int
+			if ti.out == tj.out {
+				want = 0
+			} else if i < j {
+				want = -1
+			} else {
+				want = +1
+			}
+			if cmp != want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", ti.in, tj.in, cmp, want)
+			}
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	versions := make([]string, len(tests))
+	for i, test := range tests {
+		versions[i] = test.in
+	}
+	rand.Shuffle(len(versions), func(i, j int) { versions[i], versions[j] = versions[j], versions[i] })
+	gomod.Sort(versions)
+	if !slices.IsSortedFunc(versions, gomod.Compare) {
+		t.Errorf("list is not sorted: %q", versions)
+	}
+}
+
+func TestMax(t *testing.T) {
+	for i, ti := range tests {
+		for j, tj := range tests {
+			max := gomod.Max(ti.in, tj.in)
+			want := gomod.Canonical(ti.in)
+			if i < j {
+				want = gomod.Canonical(tj.in)
+			}
+			if max != want {
+				t.Errorf("Max(%q, %q) = %q, want %q", ti.in, tj.in, max, want)
+			}
+		}
+	}
+}
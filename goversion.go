@@ -0,0 +1,253 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GoVersion is a Go toolchain version, such as "go1.21.6" or "go1.22rc1".
+// Go does not use semantic versioning for its toolchains, and orders them
+// differently: starting with Go 1.21, "go1.21" names the language version,
+// which sorts before the pre-releases and releases that implement it,
+//
+//	go1.21 < go1.21rc1 < go1.21.0 < go1.21.1
+//
+// while before Go 1.21, "go1.20" names the first release, 1.20.0.
+// The ordering matches go/version.Compare.
+type GoVersion struct {
+	Major int
+	Minor int
+	Patch int    // -1 when there is no patch, as in "go1.21" and "go1.22rc1"
+	Kind  string // "alpha", "beta" or "rc" for a pre-release, "" otherwise
+	Pre   int    // the pre-release number, as in "rc1"; -1 for a release or language version
+}
+
+// ParseGoVersion parses a Go toolchain version. The "go" prefix is optional,
+// so both "go1.21.6" from GOTOOLCHAIN or BuildInfo.GoVersion and "1.21.6"
+// from a go.mod file are accepted. A suffix starting with "-" or " ", as in
+// "go1.21.0-bigcorp" or "go1.22.1 X:boringcrypto", is ignored.
+//
+// A pre-release must have a number, as in "go1.22rc1"; "go1.22rc" is
+// rejected. The pre-Go 1.21 rule only applies to major version 1, so
+// "go2.5" is a language version.
+//
+// Examples:
+//   - "go1" is 1.0.0
+//   - "go1.20" is 1.20.0
+//   - "go1.21" is the 1.21 language version
+//   - "go1.22beta2" is the second beta of 1.22
+func ParseGoVersion(s string) (GoVersion, error) {
+	x := strings.TrimPrefix(s, "go")
+	if i := strings.IndexAny(x, "- "); i != -1 {
+		x = x[:i]
+	}
+	invalid := func() (GoVersion, error) {
+		return GoVersion{}, fmt.Errorf("semver: invalid Go version %q", s)
+	}
+
+	g := GoVersion{Patch: -1, Pre: -1}
+	var ok bool
+	if g.Major, x, ok = cutGoNumber(x); !ok {
+		return invalid()
+	}
+	if x == "" {
+		// "go1" is the 1.0.0 release
+		g.Patch = 0
+		return g, nil
+	}
+	if x[0] != '.' {
+		return invalid()
+	}
+	if g.Minor, x, ok = cutGoNumber(x[1:]); !ok {
+		return invalid()
+	}
+	if x == "" {
+		if beforeLang(g.Major, g.Minor) {
+			// before Go 1.21, "go1.N" is the 1.N.0 release
+			g.Patch = 0
+		}
+		return g, nil
+	}
+	if x[0] == '.' {
+		// Go has no pre-releases of patch releases
+		if g.Patch, x, ok = cutGoNumber(x[1:]); !ok || x != "" {
+			return invalid()
+		}
+		return g, nil
+	}
+	i := 0
+	for i < len(x) && 'a' <= x[i] && x[i] <= 'z' {
+		i++
+	}
+	if i == 0 {
+		return invalid()
+	}
+	g.Kind, x = x[:i], x[i:]
+	if g.Pre, x, ok = cutGoNumber(x); !ok || x != "" {
+		return invalid()
+	}
+	return g, nil
+}
+
+// MustParseGoVersion is like ParseGoVersion but panics if the version is invalid.
+func MustParseGoVersion(s string) GoVersion {
+	g, err := ParseGoVersion(s)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// beforeLang reports whether the Go version major.minor predates Go 1.21,
+// the first version with language versions.
+func beforeLang(major, minor int) bool {
+	return major == 1 && minor < 21
+}
+
+// cutGoNumber returns the decimal number at the start of x and the rest of x.
+// It rejects leading zeros and numbers that overflow an int.
+func cutGoNumber(x string) (int, string, bool) {
+	i := 0
+	for i < len(x) && isDigit(x[i]) {
+		i++
+	}
+	if i == 0 || (x[0] == '0' && i != 1) {
+		return 0, x, false
+	}
+	n, err := strconv.Atoi(x[:i])
+	if err != nil {
+		return 0, x, false
+	}
+	return n, x[i:], true
+}
+
+// String implements the fmt.Stringer interface and returns the version with
+// the "go" prefix. Releases before Go 1.21 use their historical names, so
+// 1.20.0 is "go1.20" and 1.0.0 is "go1".
+func (g GoVersion) String() string {
+	s := "go" + strconv.Itoa(g.Major)
+	switch {
+	case g.Minor == 0 && g.Patch == 0 && g.Kind == "":
+		return s
+	case g.Patch == 0 && beforeLang(g.Major, g.Minor):
+		s += "." + strconv.Itoa(g.Minor)
+	case g.Patch >= 0:
+		s += "." + strconv.Itoa(g.Minor) + "." + strconv.Itoa(g.Patch)
+	default:
+		s += "." + strconv.Itoa(g.Minor)
+	}
+	s += g.Kind
+	if g.Pre >= 0 {
+		s += strconv.Itoa(g.Pre)
+	}
+	return s
+}
+
+// Compare returns an integer comparing two Go versions using Go's toolchain
+// ordering. The result will be 0 if g == o, -1 if g < o, or +1 if g > o.
+func (g GoVersion) Compare(o GoVersion) int {
+	for _, n := range [][2]int{{g.Major, o.Major}, {g.Minor, o.Minor}, {g.Patch, o.Patch}} {
+		if n[0] < n[1] {
+			return -1
+		} else if n[0] > n[1] {
+			return 1
+		}
+	}
+	// the language version has no kind and sorts before alpha < beta < rc
+	if c := strings.Compare(g.Kind, o.Kind); c != 0 {
+		return c
+	}
+	if g.Pre < o.Pre {
+		return -1
+	} else if g.Pre > o.Pre {
+		return 1
+	}
+	return 0
+}
+
+// Less returns true if g sorts before o. It calls Compare(o) < 0.
+func (g GoVersion) Less(o GoVersion) bool {
+	return g.Compare(o) < 0
+}
+
+// IsLang reports whether g is a language version, such as "go1.21",
+// rather than a specific release or pre-release.
+func (g GoVersion) IsLang() bool {
+	return g.Patch == -1 && g.Kind == "" && g.Pre == -1
+}
+
+// Lang returns the language version that g implements.
+// For example, the Lang of "go1.21.6" and "go1.21rc1" is "go1.21".
+// Before Go 1.21 there were no language versions, and Lang returns the
+// first release of the minor version, so the Lang of "go1.20.3" is "go1.20".
+func (g GoVersion) Lang() GoVersion {
+	lang := GoVersion{Major: g.Major, Minor: g.Minor, Patch: -1, Pre: -1}
+	if beforeLang(g.Major, g.Minor) {
+		lang.Patch = 0
+	}
+	return lang
+}
+
+// Version returns the semantic version with the same ordering as g:
+// a release is itself, a pre-release such as "go1.22rc1" is 1.22.0-rc.1,
+// and a language version such as "go1.21" is 1.21.0-0, which sorts before
+// every pre-release of 1.21.0.
+func (g GoVersion) Version() Version {
+	v := Version{Major: g.Major, Minor: g.Minor, Patch: g.Patch}
+	if g.Patch >= 0 {
+		return v
+	}
+	v.Patch = 0
+	switch {
+	case g.Kind == "":
+		v.PreRelease = "0"
+	case g.Pre >= 0:
+		v.PreRelease = g.Kind + "." + strconv.Itoa(g.Pre)
+	default:
+		v.PreRelease = g.Kind
+	}
+	return v
+}
+
+// GoVersion converts v back into a Go toolchain version, reversing
+// GoVersion.Version. It returns an error if v has build metadata
+// or a pre-release that has no Go form.
+func (v Version) GoVersion() (GoVersion, error) {
+	g := GoVersion{Major: v.Major, Minor: v.Minor, Patch: v.Patch, Pre: -1}
+	invalid := func() (GoVersion, error) {
+		return GoVersion{}, fmt.Errorf("semver: %s has no Go version form", v)
+	}
+	if v.Build != "" || v.Major < 0 || v.Minor < 0 || v.Patch < 0 {
+		return invalid()
+	}
+	if v.PreRelease == "" {
+		return g, nil
+	}
+	if v.Patch != 0 {
+		return invalid()
+	}
+	g.Patch = -1
+	if v.PreRelease == "0" {
+		if beforeLang(v.Major, v.Minor) {
+			// 1.N for N < 21 is a release, not a language version
+			return invalid()
+		}
+		return g, nil
+	}
+	kind, pre, hasPre := strings.Cut(v.PreRelease, ".")
+	for i := 0; i < len(kind); i++ {
+		if kind[i] < 'a' || kind[i] > 'z' {
+			return invalid()
+		}
+	}
+	g.Kind = kind
+	n, rest, ok := cutGoNumber(pre)
+	if !hasPre || !ok || rest != "" {
+		return invalid()
+	}
+	g.Pre = n
+	return g, nil
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// goVersions are Go toolchain versions in increasing order.
+var goVersions = []string{
+	"go1",
+	"go1.1",
+	"go1.9rc2",
+	"go1.9",
+	"go1.9.1",
+	"go1.20rc1",
+	"go1.20",
+	"go1.20.14",
+	"go1.21",
+	"go1.21rc1",
+	"go1.21rc2",
+	"go1.21.0",
+	"go1.21.6",
+	"go1.21.10",
+	"go1.22",
+	"go1.22alpha1",
+	"go1.22beta2",
+	"go1.22rc1",
+	"go1.22.0",
+	"go2",
+}
+
+// Test for ParseGoVersion, String and Compare
+func TestGoVersionOrder(t *testing.T) {
+	for i, si := range goVersions {
+		gi := semver.MustParseGoVersion(si)
+		if gi.String() != si {
+			t.Errorf("String: expected %q, actual %q", si, gi)
+		}
+		for j, sj := range goVersions {
+			gj := semver.MustParseGoVersion(sj)
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if actual := gi.Compare(gj); actual != want {
+				t.Errorf("Compare(%s, %s): expected %d, actual %d", si, sj, want, actual)
+			}
+			// the semantic versions must sort the same way
+			if actual := gi.Version().Compare(gj.Version()); actual != want {
+				t.Errorf("Version().Compare(%s, %s): expected %d, actual %d", gi.Version(), gj.Version(), want, actual)
+			}
+		}
+	}
+}
+
+// Test for ParseGoVersion with the forms found in go.mod, GOTOOLCHAIN and BuildInfo
+func TestParseGoVersion(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+		lang     bool
+	}{
+		{"1.21.6", "go1.21.6", false},
+		{"go1.21", "go1.21", true},
+		{"1.20", "go1.20", false},
+		{"go1.21.0-bigcorp", "go1.21.0", false},
+		{"go1.22.1 X:boringcrypto", "go1.22.1", false},
+		{"go1.0", "go1", false},
+		{"go1.0.1", "go1.0.1", false},
+		{"go2.5", "go2.5", true},
+		{"go2.5.0", "go2.5.0", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			g, err := semver.ParseGoVersion(tc.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if g.String() != tc.expected || g.IsLang() != tc.lang {
+				t.Errorf("Expected %q (lang %v), actual %q (lang %v)", tc.expected, tc.lang, g, g.IsLang())
+			}
+		})
+	}
+
+	for _, input := range []string{"", "go", "go1.", "go01.2", "go1.21.", "go1.21.0rc1", "go1.21RC1", "go1.21rc01", "go1.22rc", "go1.22beta", "go1.2.3.4", "gox"} {
+		if g, err := semver.ParseGoVersion(input); err == nil {
+			t.Errorf("Expected error for %q, got %s", input, g)
+		}
+	}
+}
+
+// Test for GoVersion.Lang
+func TestGoVersionLang(t *testing.T) {
+	for input, expected := range map[string]string{
+		"go1.21.6":  "go1.21",
+		"go1.22rc1": "go1.22",
+		"go1.21":    "go1.21",
+		"go1.20.3":  "go1.20",
+		"go1.0.2":   "go1",
+		"go2.5.1":   "go2.5",
+	} {
+		if actual := semver.MustParseGoVersion(input).Lang().String(); actual != expected {
+			t.Errorf("Lang(%s): expected %q, actual %q", input, expected, actual)
+		}
+	}
+}
+
+// Test for conversion between GoVersion and Version
+func TestGoVersionConversion(t *testing.T) {
+	testCases := []struct {
+		goVersion string
+		version   string
+	}{
+		{"go1.21.6", "1.21.6"},
+		{"go1.20", "1.20.0"},
+		{"go1.21", "1.21.0-0"},
+		{"go1.22rc1", "1.22.0-rc.1"},
+		{"go1.22beta2", "1.22.0-beta.2"},
+		{"go1.9rc2", "1.9.0-rc.2"},
+		{"go2.5", "2.5.0-0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.goVersion, func(t *testing.T) {
+			v := semver.MustParseGoVersion(tc.goVersion).Version()
+			if v.String() != tc.version {
+				t.Fatalf("Version: expected %q, actual %q", tc.version, v)
+			}
+			g, err := v.GoVersion()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if g.String() != tc.goVersion {
+				t.Errorf("GoVersion: expected %q, actual %q", tc.goVersion, g)
+			}
+		})
+	}
+
+	for _, input := range []string{"1.20.0-0", "1.21.1-rc.1", "1.21.0-RC.1", "1.21.0-rc.1.2", "1.21.0+build", "1.21.0-1", "1.21.0-rc"} {
+		if g, err := semver.MustParse(input).GoVersion(); err == nil {
+			t.Errorf("Expected error for %s, got %s", input, g)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Identifier is one of the dot-separated identifiers of a pre-release or
+// build metadata, such as "rc", "2" or "5114f85".
+type Identifier string
+
+// String implements the fmt.Stringer interface.
+func (id Identifier) String() string {
+	return string(id)
+}
+
+// IsNumeric reports whether the identifier contains only digits.
+func (id Identifier) IsNumeric() bool {
+	return isNumeric(string(id))
+}
+
+// Num returns the value of a numeric identifier. The boolean is false if
+// the identifier is not numeric or its value is too large for an int.
+func (id Identifier) Num() (int, bool) {
+	if !id.IsNumeric() {
+		return 0, false
+	}
+	n, err := strconv.Atoi(string(id))
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// Compare returns an integer comparing two pre-release identifiers by
+// precedence: numeric identifiers compare by value and sort before
+// alphanumeric ones, which compare in ASCII order.
+// The result will be 0 if id == o, -1 if id < o, or +1 if id > o.
+func (id Identifier) Compare(o Identifier) int {
+	return compareIdentifier(string(id), string(o))
+}
+
+// PreReleaseIdentifiers is a pre-release split into its identifiers.
+// A nil or empty list is a normal release.
+type PreReleaseIdentifiers []Identifier
+
+// BuildIdentifiers is build metadata split into its identifiers.
+type BuildIdentifiers []Identifier
+
+// NewPreReleaseIdentifiers returns the pre-release made of ids. It returns
+// an error if an identifier is empty, contains a character other than
+// [0-9A-Za-z-], or is numeric with a leading zero.
+//
+// Example:
+//
+//	pre, _ := NewPreReleaseIdentifiers("rc", "2")
+//	v := Version{Major: 1, PreRelease: pre.String()} // 1.0.0-rc.2
+func NewPreReleaseIdentifiers(ids ...string) (PreReleaseIdentifiers, error) {
+	list, err := newIdentifiers(ids, true)
+	if err != nil {
+		return nil, fmt.Errorf("semver: invalid pre-release identifier %w", err)
+	}
+	return PreReleaseIdentifiers(list), nil
+}
+
+// NewBuildIdentifiers returns the build metadata made of ids. It returns
+// an error if an identifier is empty or contains a character other than
+// [0-9A-Za-z-]. Unlike pre-release identifiers, leading zeros are allowed.
+func NewBuildIdentifiers(ids ...string) (BuildIdentifiers, error) {
+	list, err := newIdentifiers(ids, false)
+	if err != nil {
+		return nil, fmt.Errorf("semver: invalid build identifier %w", err)
+	}
+	return BuildIdentifiers(list), nil
+}
+
+// newIdentifiers checks each of ids and converts them to Identifiers.
+func newIdentifiers(ids []string, noLeadingZero bool) ([]Identifier, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	list := make([]Identifier, len(ids))
+	for i, id := range ids {
+		if offset := strings.IndexByte(id, '.'); offset != -1 {
+			return nil, fmt.Errorf("%q at offset %d: invalid character '.'", id, offset)
+		} else if offset, msg := checkIdentifiers(id, noLeadingZero); offset != -1 {
+			return nil, fmt.Errorf("%q at offset %d: %s", id, offset, msg)
+		}
+		list[i] = Identifier(id)
+	}
+	return list, nil
+}
+
+// splitIdentifiers splits a dot-separated list, returning nil for "".
+func splitIdentifiers(s string) []Identifier {
+	if s == "" {
+		return nil
+	}
+	fields := strings.Split(s, ".")
+	list := make([]Identifier, len(fields))
+	for i, field := range fields {
+		list[i] = Identifier(field)
+	}
+	return list
+}
+
+// joinIdentifiers joins a list of identifiers with dots.
+func joinIdentifiers(list []Identifier) string {
+	var sb strings.Builder
+	for i, id := range list {
+		if i > 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteString(string(id))
+	}
+	return sb.String()
+}
+
+// indexIdentifier returns the index of the first identifier equal to name, or -1.
+func indexIdentifier(list []Identifier, name string) int {
+	for i, id := range list {
+		if string(id) == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// hasIdentifierPrefix reports whether list starts with the identifiers in prefix.
+func hasIdentifierPrefix(list []Identifier, prefix []string) bool {
+	if len(prefix) > len(list) {
+		return false
+	}
+	for i, name := range prefix {
+		if string(list[i]) != name {
+			return false
+		}
+	}
+	return true
+}
+
+// PreReleaseIdentifiers returns the pre-release of v split into its
+// identifiers, or nil if v is a normal release.
+func (v Version) PreReleaseIdentifiers() PreReleaseIdentifiers {
+	return PreReleaseIdentifiers(splitIdentifiers(v.PreRelease))
+}
+
+// BuildIdentifiers returns the build metadata of v split into its
+// identifiers, or nil if v has none.
+func (v Version) BuildIdentifiers() BuildIdentifiers {
+	return BuildIdentifiers(splitIdentifiers(v.Build))
+}
+
+// String returns the identifiers joined with dots, the form used in Version.PreRelease.
+func (p PreReleaseIdentifiers) String() string {
+	return joinIdentifiers(p)
+}
+
+// HasPrefix reports whether the pre-release starts with the given identifiers,
+// so HasPrefix("rc") is true for "rc.2" but not for "rc2" or "beta.rc".
+func (p PreReleaseIdentifiers) HasPrefix(ids ...string) bool {
+	return hasIdentifierPrefix(p, ids)
+}
+
+// Number returns the value of the numeric identifier that follows the
+// first identifier equal to name, so Number("rc") is 2 for "rc.2" and
+// "alpha.rc.2". The boolean is false if name is missing, is not followed
+// by a numeric identifier, or the value is too large for an int.
+func (p PreReleaseIdentifiers) Number(name string) (int, bool) {
+	i := indexIdentifier(p, name)
+	if i == -1 || i+1 == len(p) {
+		return 0, false
+	}
+	return p[i+1].Num()
+}
+
+// Compare returns an integer comparing the precedence of two pre-releases
+// of the same major.minor.patch. It follows the same rules as Version.Compare:
+// an empty list (a normal release) has higher precedence than any
+// pre-release, identifiers are compared in order with Identifier.Compare,
+// and when every common identifier is equal the longer list is higher.
+//
+// For versions with equal major, minor and patch numbers,
+// v.Compare(w) == v.PreReleaseIdentifiers().Compare(w.PreReleaseIdentifiers()).
+func (p PreReleaseIdentifiers) Compare(o PreReleaseIdentifiers) int {
+	// a normal release has higher precedence than a pre-release
+	if len(p) == 0 && len(o) == 0 {
+		return 0
+	} else if len(p) == 0 {
+		return 1
+	} else if len(o) == 0 {
+		return -1
+	}
+	for i := 0; i < len(p) && i < len(o); i++ {
+		if cmp := p[i].Compare(o[i]); cmp != 0 {
+			return cmp
+		}
+	}
+	// all common identifiers are equal, so the longer list has higher precedence
+	if len(p) < len(o) {
+		return -1
+	} else if len(p) > len(o) {
+		return 1
+	}
+	return 0
+}
+
+// String returns the identifiers joined with dots, the form used in Version.Build.
+func (b BuildIdentifiers) String() string {
+	return joinIdentifiers(b)
+}
+
+// HasPrefix reports whether the build metadata starts with the given identifiers.
+func (b BuildIdentifiers) HasPrefix(ids ...string) bool {
+	return hasIdentifierPrefix(b, ids)
+}
+
+// Get returns the identifier that follows the first identifier equal to
+// key, treating the build metadata as key/value pairs, so Get("sha") is
+// "5114f85" for "exp.sha.5114f85". The boolean is false if key is missing
+// or is the last identifier.
+func (b BuildIdentifiers) Get(key string) (Identifier, bool) {
+	i := indexIdentifier(b, key)
+	if i == -1 || i+1 == len(b) {
+		return "", false
+	}
+	return b[i+1], true
+}
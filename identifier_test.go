@@ -0,0 +1,153 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for Identifier
+func TestIdentifier(t *testing.T) {
+	testCases := []struct {
+		id      semver.Identifier
+		numeric bool
+		num     int
+		ok      bool
+	}{
+		{"0", true, 0, true},
+		{"42", true, 42, true},
+		{"99999999999999999999", true, 0, false},
+		{"rc", false, 0, false},
+		{"1a", false, 0, false},
+		{"-1", false, 0, false},
+		{"", false, 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.id), func(t *testing.T) {
+			if actual := tc.id.IsNumeric(); actual != tc.numeric {
+				t.Errorf("IsNumeric: expected %v, actual %v", tc.numeric, actual)
+			}
+			if num, ok := tc.id.Num(); num != tc.num || ok != tc.ok {
+				t.Errorf("Num: expected %d, %v, actual %d, %v", tc.num, tc.ok, num, ok)
+			}
+			if actual := tc.id.String(); actual != string(tc.id) {
+				t.Errorf("String: expected %q, actual %q", tc.id, actual)
+			}
+		})
+	}
+}
+
+// Test for the Version accessors and the PreReleaseIdentifiers helpers
+func TestPreReleaseIdentifiers(t *testing.T) {
+	v := semver.MustParse("1.0.0-alpha.rc.2.x+exp.sha.5114f85")
+	pre := v.PreReleaseIdentifiers()
+	if expected := (semver.PreReleaseIdentifiers{"alpha", "rc", "2", "x"}); !slices.Equal(pre, expected) {
+		t.Fatalf("PreReleaseIdentifiers: expected %q, actual %q", expected, pre)
+	}
+	if pre.String() != v.PreRelease {
+		t.Errorf("String: expected %q, actual %q", v.PreRelease, pre)
+	}
+	if !pre.HasPrefix("alpha") || !pre.HasPrefix("alpha", "rc") || !pre.HasPrefix() {
+		t.Errorf("HasPrefix: expected true for alpha, alpha.rc and the empty prefix")
+	}
+	if pre.HasPrefix("rc") || pre.HasPrefix("alph") || pre.HasPrefix("alpha", "rc", "2", "x", "y") {
+		t.Errorf("HasPrefix: expected false for rc, alph and a longer prefix")
+	}
+	if n, ok := pre.Number("rc"); n != 2 || !ok {
+		t.Errorf("Number(rc): expected 2, true, actual %d, %v", n, ok)
+	}
+	for _, name := range []string{"alpha", "x", "beta"} {
+		if n, ok := pre.Number(name); ok {
+			t.Errorf("Number(%s): expected false, actual %d, %v", name, n, ok)
+		}
+	}
+
+	build := v.BuildIdentifiers()
+	if build.String() != v.Build {
+		t.Errorf("String: expected %q, actual %q", v.Build, build)
+	}
+	if !build.HasPrefix("exp") {
+		t.Errorf("HasPrefix(exp): expected true")
+	}
+	if sha, ok := build.Get("sha"); sha != "5114f85" || !ok {
+		t.Errorf("Get(sha): expected 5114f85, true, actual %q, %v", sha, ok)
+	}
+	if id, ok := build.Get("5114f85"); ok {
+		t.Errorf("Get(5114f85): expected false, actual %q, %v", id, ok)
+	}
+
+	release := semver.MustParse("1.0.0")
+	if release.PreReleaseIdentifiers() != nil || release.BuildIdentifiers() != nil {
+		t.Errorf("Expected nil identifiers for a release without build metadata")
+	}
+}
+
+// Test for NewPreReleaseIdentifiers and NewBuildIdentifiers
+func TestNewIdentifiers(t *testing.T) {
+	testCases := []struct {
+		ids      []string
+		preErr   bool
+		buildErr bool
+	}{
+		{nil, false, false},
+		{[]string{"rc", "2"}, false, false},
+		{[]string{"x-y", "0"}, false, false},
+		{[]string{"007"}, true, false},
+		{[]string{"rc", ""}, true, true},
+		{[]string{"rc.2"}, true, true},
+		{[]string{"r_c"}, true, true},
+	}
+
+	for _, tc := range testCases {
+		pre, err := semver.NewPreReleaseIdentifiers(tc.ids...)
+		if tc.preErr != (err != nil) {
+			t.Errorf("NewPreReleaseIdentifiers(%q): expected error %v, actual %v", tc.ids, tc.preErr, err)
+		} else if err == nil && len(pre) != len(tc.ids) {
+			t.Errorf("NewPreReleaseIdentifiers(%q): expected %d identifiers, actual %q", tc.ids, len(tc.ids), pre)
+		}
+		build, err := semver.NewBuildIdentifiers(tc.ids...)
+		if tc.buildErr != (err != nil) {
+			t.Errorf("NewBuildIdentifiers(%q): expected error %v, actual %v", tc.ids, tc.buildErr, err)
+		} else if err == nil && len(build) != len(tc.ids) {
+			t.Errorf("NewBuildIdentifiers(%q): expected %d identifiers, actual %q", tc.ids, len(tc.ids), build)
+		}
+	}
+
+	pre, _ := semver.NewPreReleaseIdentifiers("rc", "2")
+	if v := (semver.Version{Major: 1, PreRelease: pre.String()}); v.String() != "1.0.0-rc.2" {
+		t.Errorf("Expected 1.0.0-rc.2, actual %q", v)
+	}
+}
+
+// Test that comparing pre-release identifiers agrees with Compare
+func TestPreReleaseIdentifiersCompare(t *testing.T) {
+	versions := []string{
+		"1.0.0-0",
+		"1.0.0-1",
+		"1.0.0-2",
+		"1.0.0-10",
+		"1.0.0-99999999999999999999",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+	}
+
+	for _, s1 := range versions {
+		for _, s2 := range versions {
+			v1, v2 := semver.MustParse(s1), semver.MustParse(s2)
+			expected := v1.Compare(v2)
+			if actual := v1.PreReleaseIdentifiers().Compare(v2.PreReleaseIdentifiers()); actual != expected {
+				t.Errorf("Compare(%s, %s): expected %d, actual %d", s1, s2, expected, actual)
+			}
+		}
+	}
+}
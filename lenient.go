@@ -0,0 +1,153 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NormalizationKind identifies a change that ParseLenient made to its input.
+type NormalizationKind int
+
+const (
+	TrimmedSpace         NormalizationKind = iota // leading or trailing white space was removed
+	StrippedPrefix                                // text before the major version ("v", "release-") was removed
+	StrippedLeadingZeros                          // leading zeros were removed from a numeric component
+	AddedMinor                                    // a missing minor version was set to 0
+	AddedPatch                                    // a missing patch version was set to 0
+	DroppedComponents                             // numeric components after the patch version were removed
+	DroppedTrailingDot                            // a trailing "." after the version core was removed
+)
+
+// String implements the fmt.Stringer interface.
+func (k NormalizationKind) String() string {
+	switch k {
+	case TrimmedSpace:
+		return "trimmed space"
+	case StrippedPrefix:
+		return "stripped prefix"
+	case StrippedLeadingZeros:
+		return "stripped leading zeros"
+	case AddedMinor:
+		return "added minor"
+	case AddedPatch:
+		return "added patch"
+	case DroppedComponents:
+		return "dropped components"
+	case DroppedTrailingDot:
+		return "dropped trailing dot"
+	}
+	return fmt.Sprintf("NormalizationKind(%d)", int(k))
+}
+
+// Normalization records one change that ParseLenient made to its input.
+type Normalization struct {
+	Kind      NormalizationKind
+	Component Component // the component that was changed
+	Text      string    // the text that was removed, or "0" for added components
+}
+
+// String implements the fmt.Stringer interface.
+func (n Normalization) String() string {
+	switch n.Kind {
+	case AddedMinor, AddedPatch:
+		return n.Kind.String()
+	case StrippedLeadingZeros:
+		return fmt.Sprintf("%s from %s %q", n.Kind, n.Component, n.Text)
+	}
+	return fmt.Sprintf("%s %q", n.Kind, n.Text)
+}
+
+// ParseLenient parses s as a semantic version, coercing common real-world
+// variations into a valid Version. It returns the list of normalizations
+// that were applied, in the order they were applied; the list is empty
+// when s is already a valid semantic version.
+//
+// The following are accepted:
+//   - surrounding white space (" 1.2.3 ")
+//   - any prefix before the major version ("v1.2.3", "V1.2.3", "release-1.4.0")
+//   - leading zeros in numeric components ("1.02.3")
+//   - missing minor or patch versions ("v1", "v1.2"), which are set to 0
+//   - extra numeric components ("1.2.3.4"), which are dropped
+//   - a trailing dot after the version core ("1.2.3.")
+//
+// Pre-release and build metadata must still follow the strict grammar.
+// On failure the returned error is a *ParseError against the original input.
+func ParseLenient(s string) (Version, []Normalization, error) {
+	var v Version
+	var norms []Normalization
+
+	input := strings.TrimSpace(s)
+	lead := strings.Index(s, input)
+	if input != s {
+		norms = append(norms, Normalization{Kind: TrimmedSpace, Component: ComponentMajor, Text: s[:lead] + s[lead+len(input):]})
+	}
+	if input == "" {
+		return Version{}, norms, &ParseError{Input: s, Offset: len(s), Component: ComponentMajor, Msg: "unexpected end of input, expected digit"}
+	}
+
+	pos := strings.IndexFunc(input, func(r rune) bool { return '0' <= r && r <= '9' })
+	if pos == -1 {
+		return Version{}, norms, &ParseError{Input: s, Offset: lead, Component: ComponentMajor, Msg: "no digits found"}
+	} else if pos > 0 {
+		norms = append(norms, Normalization{Kind: StrippedPrefix, Component: ComponentMajor, Text: input[:pos]})
+	}
+
+	// parse up to three numeric components, then drop any extras
+	numbers := []*int{&v.Major, &v.Minor, &v.Patch}
+	component := ComponentMajor
+	for {
+		start := pos
+		for pos < len(input) && isDigit(input[pos]) {
+			pos++
+		}
+		digits := input[start:pos]
+		if len(digits) > 1 && digits[0] == '0' {
+			norms = append(norms, Normalization{Kind: StrippedLeadingZeros, Component: component, Text: digits})
+		}
+		n, err := strconv.Atoi(digits)
+		if err != nil {
+			return Version{}, norms, &ParseError{Input: s, Offset: lead + start, Component: component, Msg: "value out of range"}
+		}
+		*numbers[component] = n
+
+		if pos+1 < len(input) && input[pos] == '.' && isDigit(input[pos+1]) {
+			pos++
+			if component < ComponentPatch {
+				component++
+				continue
+			}
+			// everything from here to the end of the numeric run is dropped
+			dropStart := pos - 1
+			for pos < len(input) && (isDigit(input[pos]) || (input[pos] == '.' && pos+1 < len(input) && isDigit(input[pos+1]))) {
+				pos++
+			}
+			norms = append(norms, Normalization{Kind: DroppedComponents, Component: ComponentPatch, Text: input[dropStart:pos]})
+		}
+		if pos+1 == len(input) && input[pos] == '.' {
+			norms = append(norms, Normalization{Kind: DroppedTrailingDot, Component: component, Text: "."})
+			pos++
+		}
+		break
+	}
+
+	if component < ComponentMinor {
+		norms = append(norms, Normalization{Kind: AddedMinor, Component: ComponentMinor, Text: "0"})
+	}
+	if component < ComponentPatch {
+		norms = append(norms, Normalization{Kind: AddedPatch, Component: ComponentPatch, Text: "0"})
+	}
+
+	if err := parseTail(input, pos, &v, component); err != nil {
+		var perr *ParseError
+		if errors.As(err, &perr) {
+			perr.Input, perr.Offset = s, perr.Offset+lead
+		}
+		return Version{}, norms, err
+	}
+
+	return v, norms, nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for ParseLenient with the normalizations it reports
+func TestParseLenient(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		input    string
+		expected semver.Version
+		kinds    []semver.NormalizationKind
+	}{
+		{
+			desc:     "already valid",
+			input:    "1.2.3-rc.1+b5",
+			expected: semver.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1", Build: "b5"},
+		},
+		{
+			desc:     "v prefix and missing patch",
+			input:    "v1.2",
+			expected: semver.Version{Major: 1, Minor: 2, Patch: 0},
+			kinds:    []semver.NormalizationKind{semver.StrippedPrefix, semver.AddedPatch},
+		},
+		{
+			desc:     "major only",
+			input:    "v7",
+			expected: semver.Version{Major: 7},
+			kinds:    []semver.NormalizationKind{semver.StrippedPrefix, semver.AddedMinor, semver.AddedPatch},
+		},
+		{
+			desc:     "upper case prefix and trailing dot",
+			input:    "V1.2.3.",
+			expected: semver.Version{Major: 1, Minor: 2, Patch: 3},
+			kinds:    []semver.NormalizationKind{semver.StrippedPrefix, semver.DroppedTrailingDot},
+		},
+		{
+			desc:     "surrounding white space",
+			input:    " 1.2.3 ",
+			expected: semver.Version{Major: 1, Minor: 2, Patch: 3},
+			kinds:    []semver.NormalizationKind{semver.TrimmedSpace},
+		},
+		{
+			desc:     "word prefix",
+			input:    "release-1.4.0",
+			expected: semver.Version{Major: 1, Minor: 4, Patch: 0},
+			kinds:    []semver.NormalizationKind{semver.StrippedPrefix},
+		},
+		{
+			desc:     "extra component",
+			input:    "1.2.3.4",
+			expected: semver.Version{Major: 1, Minor: 2, Patch: 3},
+			kinds:    []semver.NormalizationKind{semver.DroppedComponents},
+		},
+		{
+			desc:     "extra components with pre-release",
+			input:    "1.2.3.4.5-beta",
+			expected: semver.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "beta"},
+			kinds:    []semver.NormalizationKind{semver.DroppedComponents},
+		},
+		{
+			desc:     "leading zeros",
+			input:    "01.002.3",
+			expected: semver.Version{Major: 1, Minor: 2, Patch: 3},
+			kinds:    []semver.NormalizationKind{semver.StrippedLeadingZeros, semver.StrippedLeadingZeros},
+		},
+		{
+			desc:     "missing patch with pre-release",
+			input:    "v2.0-rc.1",
+			expected: semver.Version{Major: 2, Minor: 0, Patch: 0, PreRelease: "rc.1"},
+			kinds:    []semver.NormalizationKind{semver.StrippedPrefix, semver.AddedPatch},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actual, norms, err := semver.ParseLenient(tc.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !actual.Equal(tc.expected) {
+				t.Errorf("Unexpected version. expected: %v, actual: %v", tc.expected, actual)
+			}
+			var kinds []semver.NormalizationKind
+			for _, n := range norms {
+				kinds = append(kinds, n.Kind)
+			}
+			if !reflect.DeepEqual(kinds, tc.kinds) {
+				t.Errorf("Unexpected normalizations. expected: %v, actual: %v", tc.kinds, norms)
+			}
+		})
+	}
+}
+
+// Test for ParseLenient with invalid input
+func TestParseLenientError(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		input     string
+		offset    int
+		component semver.Component
+	}{
+		{desc: "empty string", input: "", offset: 0, component: semver.ComponentMajor},
+		{desc: "no digits", input: "latest", offset: 0, component: semver.ComponentMajor},
+		{desc: "bad pre-release", input: " v1.2-rc..1", offset: 9, component: semver.ComponentPreRelease},
+		{desc: "junk after core", input: "1.2.3_x", offset: 5, component: semver.ComponentPatch},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, _, err := semver.ParseLenient(tc.input)
+			var perr *semver.ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Expected *ParseError, got %v", err)
+			}
+			if perr.Input != tc.input {
+				t.Errorf("Unexpected input. expected: %q, actual: %q", tc.input, perr.Input)
+			}
+			if perr.Offset != tc.offset {
+				t.Errorf("Unexpected offset. expected: %d, actual: %d (%v)", tc.offset, perr.Offset, perr)
+			}
+			if perr.Component != tc.component {
+				t.Errorf("Unexpected component. expected: %v, actual: %v (%v)", tc.component, perr.Component, perr)
+			}
+		})
+	}
+}
+
+// Test for sorting legacy tags parsed with ParseLenient
+func TestParseLenientSort(t *testing.T) {
+	tags := []string{"v1.10", "release-1.4.0", "1.2.3.4", " v1.2 ", "v1.10.0-rc.1"}
+	var versions []semver.Version
+	for _, tag := range tags {
+		v, _, err := semver.ParseLenient(tag)
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %v", tag, err)
+		}
+		versions = append(versions, v)
+	}
+	sort.Sort(semver.ByVersion(versions))
+	expected := []string{"1.2.0", "1.2.3", "1.4.0", "1.10.0-rc.1", "1.10.0"}
+	for i, v := range versions {
+		if v.String() != expected[i] {
+			t.Errorf("Version at index %d mismatch. expected: %s, actual: %s", i, expected[i], v)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Component identifies one part of a semantic version string.
+// It is used by ParseError to report which part failed to parse.
+type Component int
+
+const (
+	ComponentMajor      Component = iota // the major version number
+	ComponentMinor                       // the minor version number
+	ComponentPatch                       // the patch version number
+	ComponentPreRelease                  // the pre-release identifiers
+	ComponentBuild                       // the build metadata identifiers
+)
+
+// String implements the fmt.Stringer interface.
+func (c Component) String() string {
+	switch c {
+	case ComponentMajor:
+		return "major"
+	case ComponentMinor:
+		return "minor"
+	case ComponentPatch:
+		return "patch"
+	case ComponentPreRelease:
+		return "prerelease"
+	case ComponentBuild:
+		return "build"
+	}
+	return fmt.Sprintf("Component(%d)", int(c))
+}
+
+// ParseError is returned by Parse when the input is not a valid semantic version.
+// It records the input, the byte offset of the offending character and the
+// component that was being parsed, so callers can point at the exact problem.
+type ParseError struct {
+	Input     string    // the string that was being parsed
+	Offset    int       // byte offset into Input where the error was detected
+	Component Component // the component that failed to parse
+	Msg       string    // description of the problem
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("semver: invalid %s in %q at offset %d: %s", e.Component, e.Input, e.Offset, e.Msg)
+}
+
+// Caret returns the input on one line followed by a line with a caret
+// under the offending byte. It is intended for command line tools.
+//
+// Example:
+//
+//	1.02.3
+//	  ^
+func (e *ParseError) Caret() string {
+	return e.Input + "\n" + strings.Repeat(" ", e.Offset) + "^"
+}
+
+// Parse parses s as a semantic version.
+// The input must match the grammar from https://semver.org/ exactly:
+// no leading "v", no leading zeros in numeric components or numeric
+// pre-release identifiers, no empty identifiers, and only the characters
+// [0-9A-Za-z-] in pre-release and build identifiers.
+//
+// On failure the returned error is a *ParseError.
+//
+// Examples:
+//   - Parse("1.0.0") returns Version{1, 0, 0, "", ""}
+//   - Parse("1.0.0-beta+exp.sha.5114f85") returns Version{1, 0, 0, "beta", "exp.sha.5114f85"}
+//   - Parse("1.02.0") returns an error for the leading zero in the minor component
+func Parse(s string) (Version, error) {
+	var v Version
+	var err error
+	pos := 0
+
+	if v.Major, pos, err = parseNumber(s, pos, ComponentMajor); err != nil {
+		return Version{}, err
+	} else if pos, err = expectDot(s, pos, ComponentMajor); err != nil {
+		return Version{}, err
+	}
+	if v.Minor, pos, err = parseNumber(s, pos, ComponentMinor); err != nil {
+		return Version{}, err
+	} else if pos, err = expectDot(s, pos, ComponentMinor); err != nil {
+		return Version{}, err
+	}
+	if v.Patch, pos, err = parseNumber(s, pos, ComponentPatch); err != nil {
+		return Version{}, err
+	}
+
+	if err = parseTail(s, pos, &v, ComponentPatch); err != nil {
+		return Version{}, err
+	}
+
+	return v, nil
+}
+
+// MustParse is like Parse but panics if s is not a valid semantic version.
+// It simplifies safe initialization of global variables and tests.
+func MustParse(s string) Version {
+	v, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// parseTail parses the optional pre-release and build metadata that follow
+// the version core, starting at pos, and stores them in v.
+// Any other trailing text is reported against component last.
+func parseTail(s string, pos int, v *Version, last Component) error {
+	if pos < len(s) && s[pos] == '-' {
+		pos++
+		end := strings.IndexByte(s[pos:], '+')
+		if end == -1 {
+			end = len(s)
+		} else {
+			end += pos
+		}
+		if offset, msg := checkIdentifiers(s[pos:end], true); offset != -1 {
+			return &ParseError{Input: s, Offset: pos + offset, Component: ComponentPreRelease, Msg: msg}
+		}
+		v.PreRelease, pos = s[pos:end], end
+	}
+
+	if pos < len(s) && s[pos] == '+' {
+		pos++
+		if offset, msg := checkIdentifiers(s[pos:], false); offset != -1 {
+			return &ParseError{Input: s, Offset: pos + offset, Component: ComponentBuild, Msg: msg}
+		}
+		v.Build, pos = s[pos:], len(s)
+	}
+
+	if pos < len(s) {
+		return &ParseError{Input: s, Offset: pos, Component: last, Msg: fmt.Sprintf("unexpected character %q", s[pos])}
+	}
+	return nil
+}
+
+// parseNumber parses a numeric component starting at pos.
+// It returns the value and the position of the first byte after the digits.
+func parseNumber(s string, pos int, c Component) (int, int, error) {
+	start := pos
+	digits, pos, err := parseDigits(s, pos, c)
+	if err != nil {
+		return 0, pos, err
+	}
+	n, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0, pos, &ParseError{Input: s, Offset: start, Component: c, Msg: "value out of range"}
+	}
+	return n, pos, nil
+}
+
+// parseDigits parses the decimal digits of a numeric component starting at pos.
+// It returns the digits and the position of the first byte after them.
+func parseDigits(s string, pos int, c Component) (string, int, error) {
+	start := pos
+	for pos < len(s) && isDigit(s[pos]) {
+		pos++
+	}
+	if pos == start {
+		if start == len(s) {
+			return "", pos, &ParseError{Input: s, Offset: start, Component: c, Msg: "unexpected end of input, expected digit"}
+		}
+		return "", pos, &ParseError{Input: s, Offset: start, Component: c, Msg: fmt.Sprintf("unexpected character %q, expected digit", s[start])}
+	}
+	if s[start] == '0' && pos-start > 1 {
+		return "", pos, &ParseError{Input: s, Offset: start, Component: c, Msg: "leading zero"}
+	}
+	return s[start:pos], pos, nil
+}
+
+// expectDot checks that the byte at pos is the '.' that ends component c.
+func expectDot(s string, pos int, c Component) (int, error) {
+	if pos == len(s) {
+		return pos, &ParseError{Input: s, Offset: pos, Component: c, Msg: "unexpected end of input, expected '.'"}
+	} else if s[pos] != '.' {
+		return pos, &ParseError{Input: s, Offset: pos, Component: c, Msg: fmt.Sprintf("unexpected character %q, expected '.'", s[pos])}
+	}
+	return pos + 1, nil
+}
+
+// checkIdentifiers checks a dot-separated list of pre-release or build identifiers.
+// Identifiers must be non-empty and contain only [0-9A-Za-z-].
+// When noLeadingZero is set, numeric identifiers must not have leading zeros,
+// which is the rule for pre-release identifiers but not for build metadata.
+// It returns the offset of the first problem and a description,
+// or an offset of -1 if the list is valid.
+func checkIdentifiers(s string, noLeadingZero bool) (int, string) {
+	start := 0
+	for {
+		end := strings.IndexByte(s[start:], '.')
+		if end == -1 {
+			end = len(s)
+		} else {
+			end += start
+		}
+		if start == end {
+			return start, "empty identifier"
+		}
+		numeric := true
+		for i := start; i < end; i++ {
+			if !isIdentifierChar(s[i]) {
+				return i, fmt.Sprintf("invalid character %q", s[i])
+			}
+			numeric = numeric && isDigit(s[i])
+		}
+		if noLeadingZero && numeric && s[start] == '0' && end-start > 1 {
+			return start, "leading zero in numeric identifier"
+		}
+		if end == len(s) {
+			return -1, ""
+		}
+		start = end + 1
+	}
+}
+
+// isDigit reports whether ch is an ASCII digit.
+func isDigit(ch byte) bool {
+	return '0' <= ch && ch <= '9'
+}
+
+// isIdentifierChar reports whether ch may appear in a pre-release or build identifier.
+func isIdentifierChar(ch byte) bool {
+	return isDigit(ch) || ('a' <= ch && ch <= 'z') || ('A' <= ch && ch <= 'Z') || ch == '-'
+}
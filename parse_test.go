@@ -0,0 +1,156 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for Parse with valid input
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		input    string
+		expected semver.Version
+	}{
+		{
+			desc:     "Major, minor and patch only",
+			input:    "1.2.3",
+			expected: semver.Version{Major: 1, Minor: 2, Patch: 3},
+		},
+		{
+			desc:     "zero version",
+			input:    "0.0.0",
+			expected: semver.Version{},
+		},
+		{
+			desc:     "PreRelease only",
+			input:    "1.0.0-alpha.1",
+			expected: semver.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "alpha.1"},
+		},
+		{
+			desc:     "Build only",
+			input:    "1.0.0+20130313144700",
+			expected: semver.Version{Major: 1, Minor: 0, Patch: 0, Build: "20130313144700"},
+		},
+		{
+			desc:     "PreRelease and Build",
+			input:    "1.0.0-beta+exp.sha.5114f85",
+			expected: semver.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "beta", Build: "exp.sha.5114f85"},
+		},
+		{
+			desc:     "hyphens in identifiers",
+			input:    "1.0.0-x-y-z.--+b-1",
+			expected: semver.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "x-y-z.--", Build: "b-1"},
+		},
+		{
+			desc:     "leading zeros allowed in build metadata",
+			input:    "1.0.0+001",
+			expected: semver.Version{Major: 1, Minor: 0, Patch: 0, Build: "001"},
+		},
+		{
+			desc:     "alphanumeric pre-release identifier with leading zero",
+			input:    "1.0.0-0a",
+			expected: semver.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "0a"},
+		},
+		{
+			desc:     "multi-digit components",
+			input:    "10.20.30",
+			expected: semver.Version{Major: 10, Minor: 20, Patch: 30},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actual, err := semver.Parse(tc.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !actual.Equal(tc.expected) {
+				t.Errorf("Unexpected version. expected: %v, actual: %v", tc.expected, actual)
+			}
+			if actual.String() != tc.input {
+				t.Errorf("Round trip failed. expected: %v, actual: %v", tc.input, actual.String())
+			}
+		})
+	}
+}
+
+// Test for Parse with invalid input
+func TestParseError(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		input     string
+		offset    int
+		component semver.Component
+	}{
+		{desc: "empty string", input: "", offset: 0, component: semver.ComponentMajor},
+		{desc: "leading v", input: "v1.2.3", offset: 0, component: semver.ComponentMajor},
+		{desc: "missing minor", input: "1", offset: 1, component: semver.ComponentMajor},
+		{desc: "missing patch", input: "1.2", offset: 3, component: semver.ComponentMinor},
+		{desc: "empty minor", input: "1..3", offset: 2, component: semver.ComponentMinor},
+		{desc: "leading zero in major", input: "01.2.3", offset: 0, component: semver.ComponentMajor},
+		{desc: "leading zero in minor", input: "1.02.3", offset: 2, component: semver.ComponentMinor},
+		{desc: "leading zero in patch", input: "1.2.03", offset: 4, component: semver.ComponentPatch},
+		{desc: "fourth component", input: "1.2.3.4", offset: 5, component: semver.ComponentPatch},
+		{desc: "trailing space", input: "1.2.3 ", offset: 5, component: semver.ComponentPatch},
+		{desc: "empty pre-release", input: "1.2.3-", offset: 6, component: semver.ComponentPreRelease},
+		{desc: "empty pre-release identifier", input: "1.2.3-alpha..1", offset: 12, component: semver.ComponentPreRelease},
+		{desc: "trailing dot in pre-release", input: "1.2.3-alpha.", offset: 12, component: semver.ComponentPreRelease},
+		{desc: "leading zero in numeric pre-release", input: "1.2.3-alpha.01", offset: 12, component: semver.ComponentPreRelease},
+		{desc: "invalid character in pre-release", input: "1.2.3-al_pha", offset: 8, component: semver.ComponentPreRelease},
+		{desc: "empty build", input: "1.2.3+", offset: 6, component: semver.ComponentBuild},
+		{desc: "empty build identifier", input: "1.2.3-rc+a..b", offset: 11, component: semver.ComponentBuild},
+		{desc: "second plus in build", input: "1.2.3+a+b", offset: 7, component: semver.ComponentBuild},
+		{desc: "non-ascii build", input: "1.2.3+ü", offset: 6, component: semver.ComponentBuild},
+		{desc: "major out of range", input: "99999999999999999999.0.0", offset: 0, component: semver.ComponentMajor},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := semver.Parse(tc.input)
+			var perr *semver.ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Expected *ParseError, got %v", err)
+			}
+			if perr.Input != tc.input {
+				t.Errorf("Unexpected input. expected: %q, actual: %q", tc.input, perr.Input)
+			}
+			if perr.Offset != tc.offset {
+				t.Errorf("Unexpected offset. expected: %d, actual: %d (%v)", tc.offset, perr.Offset, perr)
+			}
+			if perr.Component != tc.component {
+				t.Errorf("Unexpected component. expected: %v, actual: %v (%v)", tc.component, perr.Component, perr)
+			}
+		})
+	}
+}
+
+// Test for ParseError caret rendering
+func TestParseErrorCaret(t *testing.T) {
+	_, err := semver.Parse("1.02.3")
+	var perr *semver.ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("Expected *ParseError, got %v", err)
+	}
+	expected := "1.02.3\n  ^"
+	if actual := perr.Caret(); actual != expected {
+		t.Errorf("Unexpected caret. expected: %q, actual: %q", expected, actual)
+	}
+}
+
+// Test for MustParse panicking on invalid input
+func TestMustParse(t *testing.T) {
+	if v := semver.MustParse("1.2.3-rc.1"); !v.Equal(semver.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1"}) {
+		t.Errorf("Unexpected version: %v", v)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected MustParse to panic on invalid input")
+		}
+	}()
+	semver.MustParse("1.2")
+}
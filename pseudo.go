@@ -0,0 +1,137 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// pseudoTimeFormat is the layout of the commit time in a pseudo-version.
+const pseudoTimeFormat = "20060102150405"
+
+// Pseudo describes a Go module pseudo-version, the version the go command
+// gives to an untagged commit. There are three forms, chosen by Base:
+//
+//   - vX.0.0-yyyymmddhhmmss-abcdef123456 when there is no earlier tag (Base is nil)
+//   - vX.Y.Z-pre.0.yyyymmddhhmmss-abcdef123456 when the latest tag is the pre-release vX.Y.Z-pre
+//   - vX.Y.(Z+1)-0.yyyymmddhhmmss-abcdef123456 when the latest tag is the release vX.Y.Z
+//
+// See https://go.dev/ref/mod#pseudo-versions.
+type Pseudo struct {
+	Base     *Version  // the latest tag before the commit, or nil if there is none; build metadata is ignored
+	Major    int       // the major version, used when Base is nil
+	Time     time.Time // the commit time, written in UTC
+	Revision string    // the commit hash, shortened to 12 characters
+	Build    string    // build metadata of the pseudo-version, such as "incompatible"
+}
+
+// Version returns the pseudo-version. It returns an error if the revision
+// is empty or contains characters other than [0-9A-Za-z], if Base has no
+// valid pre-release to extend, or if Base is a release whose patch number
+// is math.MaxInt, so that the next patch does not fit in an int.
+//
+// Example:
+//
+//	base := MustParse("1.2.3")
+//	Pseudo{Base: &base, Time: t, Revision: "abcdef1234567890"}.Version()
+//	// 1.2.4-0.20250129101010-abcdef123456
+func (p Pseudo) Version() (Version, error) {
+	rev := p.Revision
+	if len(rev) > 12 {
+		rev = rev[:12]
+	}
+	if rev == "" {
+		return Version{}, fmt.Errorf("semver: pseudo-version needs a revision")
+	}
+	for i := 0; i < len(rev); i++ {
+		if !isIdentifierChar(rev[i]) || rev[i] == '-' {
+			return Version{}, fmt.Errorf("semver: invalid revision %q for pseudo-version", p.Revision)
+		}
+	}
+	suffix := p.Time.UTC().Format(pseudoTimeFormat) + "-" + rev
+
+	if p.Base == nil {
+		return Version{Major: p.Major, PreRelease: suffix, Build: p.Build}, nil
+	}
+	v := Version{Major: p.Base.Major, Minor: p.Base.Minor, Patch: p.Base.Patch, Build: p.Build}
+	if p.Base.PreRelease != "" {
+		if offset, msg := checkIdentifiers(p.Base.PreRelease, true); offset != -1 {
+			return Version{}, fmt.Errorf("semver: invalid base pre-release %q at offset %d: %s", p.Base.PreRelease, offset, msg)
+		}
+		v.PreRelease = p.Base.PreRelease + ".0." + suffix
+	} else if v.Patch == math.MaxInt {
+		return Version{}, fmt.Errorf("semver: base %s has no next patch for a pseudo-version", p.Base)
+	} else {
+		v.Patch++
+		v.PreRelease = "0." + suffix
+	}
+	return v, nil
+}
+
+// ParsePseudo parses v as a Go pseudo-version and returns its base, time
+// and revision. A base of vX.Y.Z-0 is only recognized as the release form
+// when Z > 0, since it stands for the tag vX.Y.(Z-1).
+// It returns an error if v is not a pseudo-version.
+func ParsePseudo(v Version) (Pseudo, error) {
+	notPseudo := func(why string) (Pseudo, error) {
+		return Pseudo{}, fmt.Errorf("semver: %s is not a pseudo-version: %s", v, why)
+	}
+	j := strings.LastIndexByte(v.PreRelease, '-')
+	if j == -1 {
+		return notPseudo("missing revision")
+	}
+	rest, rev := v.PreRelease[:j], v.PreRelease[j+1:]
+	if rev == "" || strings.Contains(rev, ".") {
+		return notPseudo("invalid revision")
+	}
+	if len(rest) < len(pseudoTimeFormat) {
+		return notPseudo("missing timestamp")
+	}
+	stamp := rest[len(rest)-len(pseudoTimeFormat):]
+	t, err := time.Parse(pseudoTimeFormat, stamp)
+	if err != nil || !isNumeric(stamp) {
+		return notPseudo("invalid timestamp")
+	}
+	p := Pseudo{Major: v.Major, Time: t, Revision: rev, Build: v.Build}
+
+	prefix := rest[:len(rest)-len(stamp)]
+	switch {
+	case prefix == "":
+		if v.Minor != 0 || v.Patch != 0 {
+			return notPseudo("untagged form must be vX.0.0")
+		}
+		return p, nil
+	case !strings.HasSuffix(prefix, "."):
+		return notPseudo("invalid timestamp")
+	case prefix == "0.":
+		if v.Patch == 0 {
+			return notPseudo("release form must have a patch greater than 0")
+		}
+		p.Base = &Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch - 1}
+	case strings.HasSuffix(prefix, ".0.") && len(prefix) > len(".0."):
+		p.Base = &Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, PreRelease: prefix[:len(prefix)-len(".0.")]}
+	default:
+		return notPseudo("missing .0 before the timestamp")
+	}
+	return p, nil
+}
+
+// IsPseudo reports whether v is a Go pseudo-version.
+func (v Version) IsPseudo() bool {
+	_, err := ParsePseudo(v)
+	return err == nil
+}
+
+// PseudoVersion returns the pseudo-version of the build's commit, using the
+// vcs.revision and vcs.time settings, with base as the latest tag before it
+// (nil if there is none) and major as the major version when base is nil.
+// It returns an error if the build information has no revision or time.
+func (b BuildInfo) PseudoVersion(base *Version, major int) (Version, error) {
+	if b.Revision == "" || b.Time.IsZero() {
+		return Version{}, fmt.Errorf("semver: build information has no vcs.revision and vcs.time")
+	}
+	return Pseudo{Base: base, Major: major, Time: b.Time, Revision: b.Revision}.Version()
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for Pseudo.Version and ParsePseudo
+func TestPseudo(t *testing.T) {
+	commitTime := time.Date(2025, 1, 29, 10, 10, 10, 0, time.UTC)
+	testCases := []struct {
+		base     string // empty for no tag
+		major    int
+		build    string
+		expected string
+	}{
+		{"", 0, "", "0.0.0-20250129101010-abcdef123456"},
+		{"", 2, "", "2.0.0-20250129101010-abcdef123456"},
+		{"", 2, "incompatible", "2.0.0-20250129101010-abcdef123456+incompatible"},
+		{"1.2.3", 0, "", "1.2.4-0.20250129101010-abcdef123456"},
+		{"1.2.3-pre", 0, "", "1.2.3-pre.0.20250129101010-abcdef123456"},
+		{"1.2.3-rc.1", 0, "", "1.2.3-rc.1.0.20250129101010-abcdef123456"},
+		{"1.2.3-0", 0, "", "1.2.3-0.0.20250129101010-abcdef123456"},
+		{"2.1.0", 0, "incompatible", "2.1.1-0.20250129101010-abcdef123456+incompatible"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			p := semver.Pseudo{Major: tc.major, Time: commitTime.In(time.FixedZone("CET", 3600)), Revision: "abcdef1234567890abcdef1234567890abcdef12", Build: tc.build}
+			if tc.base != "" {
+				base := semver.MustParse(tc.base)
+				p.Base = &base
+			}
+			v, err := p.Version()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if v.String() != tc.expected {
+				t.Fatalf("Unexpected pseudo-version. expected: %q, actual: %q", tc.expected, v)
+			}
+			if !v.IsPseudo() {
+				t.Errorf("IsPseudo(%s) is false", v)
+			}
+
+			parsed, err := semver.ParsePseudo(v)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !parsed.Time.Equal(commitTime) || parsed.Revision != "abcdef123456" || parsed.Build != tc.build {
+				t.Errorf("Unexpected parse %+v", parsed)
+			}
+			switch {
+			case tc.base == "" && (parsed.Base != nil || parsed.Major != tc.major):
+				t.Errorf("Expected no base and major %d, actual %v %d", tc.major, parsed.Base, parsed.Major)
+			case tc.base != "" && (parsed.Base == nil || parsed.Base.String() != tc.base):
+				t.Errorf("Expected base %s, actual %v", tc.base, parsed.Base)
+			}
+		})
+	}
+}
+
+// Test for ParsePseudo with versions that are not pseudo-versions
+func TestParsePseudoError(t *testing.T) {
+	for _, input := range []string{
+		"1.2.3",
+		"1.2.3-rc.1",
+		"1.2.3-20250129101010-abcdef123456",
+		"1.2.0-0.20250129101010-abcdef123456",
+		"1.2.3-pre.20250129101010-abcdef123456",
+		"1.2.3-pre.0.2025012910101-abcdef123456",
+		"1.2.3-pre.0.20251329101010-abcdef123456",
+		"0.0.0-20250129101010-",
+		"0.0.0-20250129101010-abc.def",
+	} {
+		v := semver.MustParse(input)
+		if p, err := semver.ParsePseudo(v); err == nil {
+			t.Errorf("Expected error for %s, got %+v", input, p)
+		}
+		if v.IsPseudo() {
+			t.Errorf("IsPseudo(%s) is true", input)
+		}
+	}
+}
+
+// Test for Pseudo.Version with an invalid revision or base
+func TestPseudoVersionError(t *testing.T) {
+	for _, rev := range []string{"", "abc-def", "abc.def"} {
+		if v, err := (semver.Pseudo{Time: time.Now(), Revision: rev}).Version(); err == nil {
+			t.Errorf("Expected error for revision %q, got %s", rev, v)
+		}
+	}
+	base := semver.Version{Major: 1, Minor: 2, Patch: math.MaxInt}
+	if v, err := (semver.Pseudo{Base: &base, Time: time.Now(), Revision: "abcdef123456"}).Version(); err == nil {
+		t.Errorf("Expected error for base %s, got %s", base, v)
+	}
+	base.PreRelease = "rc.1"
+	if _, err := (semver.Pseudo{Base: &base, Time: time.Now(), Revision: "abcdef123456"}).Version(); err != nil {
+		t.Errorf("Unexpected error for base %s: %v", base, err)
+	}
+}
+
+// Test for BuildInfo.PseudoVersion
+func TestBuildInfoPseudoVersion(t *testing.T) {
+	info, err := semver.ParseBuildInfo(goVersionDump)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	b, _ := semver.ReadBuildInfoFrom(semver.StaticBuildInfo{Info: info})
+	base := semver.MustParse("1.4.2")
+	if v, err := b.PseudoVersion(&base, 0); err != nil || v.String() != "1.4.3-0.20250314150926-5114f85c1d6a" {
+		t.Errorf("Expected 1.4.3-0.20250314150926-5114f85c1d6a, got %q (%v)", v, err)
+	}
+	if _, err := (semver.BuildInfo{}).PseudoVersion(nil, 0); err == nil {
+		t.Errorf("Expected error without vcs settings")
+	}
+}
@@ -0,0 +1,329 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"slices"
+	"strings"
+)
+
+// Interval is a half-open interval of versions ordered by Compare.
+// It contains every version v with Lower <= v < Upper, or Lower <= v
+// when Unbounded is set.
+type Interval struct {
+	Lower     Version // inclusive lower bound
+	Upper     Version // exclusive upper bound, ignored when Unbounded is set
+	Unbounded bool    // the interval has no upper bound
+}
+
+// Contains reports whether v lies in the interval.
+func (i Interval) Contains(v Version) bool {
+	return v.Compare(i.Lower) >= 0 && (i.Unbounded || v.Compare(i.Upper) < 0)
+}
+
+// String implements the fmt.Stringer interface.
+// It returns the interval in mathematical notation, such as "[1.2.0, 2.0.0)" or "[1.2.0, ∞)".
+func (i Interval) String() string {
+	if i.Unbounded {
+		return "[" + i.Lower.String() + ", ∞)"
+	}
+	return "[" + i.Lower.String() + ", " + i.Upper.String() + ")"
+}
+
+// Range is a set of versions, stored as normalized half-open intervals
+// ordered by Compare. Ranges support set algebra, so the ranges from
+// several constraints can be combined and checked for emptiness before
+// any resolution is attempted.
+//
+// Because a Constraint does not admit every pre-release that lies between
+// its bounds, a Range keeps two lists of intervals: one that applies to
+// normal releases and one that applies to pre-releases. A release is in the
+// range if it lies in one of the release intervals, and a pre-release is in
+// the range if it lies in one of the pre-release intervals.
+//
+// The intervals are normalized so that two ranges containing the same
+// versions have the same intervals, and therefore the same String:
+// every bound is moved up to the lowest version that could be a member,
+// so release intervals have release bounds and pre-release intervals
+// have pre-release bounds.
+// The zero value is the empty range.
+type Range struct {
+	releases    []Interval
+	preReleases []Interval
+}
+
+// minVersion is the lowest version, 0.0.0-0.
+var minVersion = Version{PreRelease: "0"}
+
+// FullRange returns the range that contains every version.
+func FullRange() Range {
+	return Range{
+		releases:    []Interval{{Lower: Version{}, Unbounded: true}},
+		preReleases: []Interval{{Lower: minVersion, Unbounded: true}},
+	}
+}
+
+// IntervalRange returns the range that contains every version in
+// the interval, both releases and pre-releases.
+func IntervalRange(i Interval) Range {
+	return newRange([]Interval{i}, []Interval{i})
+}
+
+// newRange returns a normalized range from the given interval lists.
+func newRange(releases, preReleases []Interval) Range {
+	return Range{
+		releases:    normalizeIntervals(releases, releaseCeil),
+		preReleases: normalizeIntervals(preReleases, preReleaseCeil),
+	}
+}
+
+// Range returns the set of versions that satisfy the constraint,
+// including the effect of the pre-release rule and IncludePreRelease.
+// For every version v, c.Range().Contains(v) == c.Check(v).
+func (c Constraint) Range() Range {
+	var r Range
+	for _, set := range c.Sets {
+		bounds := []Interval{{Lower: minVersion, Unbounded: true}}
+		for _, cmp := range set {
+			bounds = intersectIntervals(bounds, cmp.intervals())
+		}
+		preReleases := bounds
+		if !c.IncludePreRelease {
+			var cores []Interval
+			for _, cmp := range set {
+				if cmp.Version.PreRelease != "" {
+					// every pre-release of the comparator's core
+					core := releaseCeil(cmp.Version)
+					first := core
+					first.PreRelease = "0"
+					cores = append(cores, Interval{Lower: first, Upper: core})
+				}
+			}
+			preReleases = intersectIntervals(bounds, normalizeIntervals(cores, stripBuild))
+		}
+		r = r.Union(newRange(bounds, preReleases))
+	}
+	return r
+}
+
+// intervals returns the versions that satisfy the comparator by precedence alone.
+func (c Comparator) intervals() []Interval {
+	v := stripBuild(c.Version)
+	switch c.Op {
+	case OpLess:
+		return []Interval{{Lower: minVersion, Upper: v}}
+	case OpLessEqual:
+		return []Interval{{Lower: minVersion, Upper: successor(v)}}
+	case OpGreater:
+		return []Interval{{Lower: successor(v), Unbounded: true}}
+	case OpGreaterEqual:
+		return []Interval{{Lower: v, Unbounded: true}}
+	}
+	return []Interval{{Lower: v, Upper: successor(v)}}
+}
+
+// Releases returns the intervals that apply to normal releases.
+func (r Range) Releases() []Interval {
+	return append([]Interval(nil), r.releases...)
+}
+
+// PreReleases returns the intervals that apply to pre-releases.
+func (r Range) PreReleases() []Interval {
+	return append([]Interval(nil), r.preReleases...)
+}
+
+// Contains reports whether v is in the range.
+func (r Range) Contains(v Version) bool {
+	list := r.releases
+	if v.PreRelease != "" {
+		list = r.preReleases
+	}
+	for _, i := range list {
+		if i.Contains(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEmpty reports whether no version is in the range.
+func (r Range) IsEmpty() bool {
+	return len(r.releases) == 0 && len(r.preReleases) == 0
+}
+
+// Equal reports whether r and o contain the same versions.
+func (r Range) Equal(o Range) bool {
+	return equalIntervals(r.releases, o.releases) && equalIntervals(r.preReleases, o.preReleases)
+}
+
+// Intersect returns the versions that are in both r and o.
+func (r Range) Intersect(o Range) Range {
+	return newRange(intersectIntervals(r.releases, o.releases), intersectIntervals(r.preReleases, o.preReleases))
+}
+
+// Union returns the versions that are in either r or o.
+func (r Range) Union(o Range) Range {
+	return newRange(append(r.Releases(), o.releases...), append(r.PreReleases(), o.preReleases...))
+}
+
+// Complement returns the versions that are not in r.
+func (r Range) Complement() Range {
+	return newRange(complementIntervals(r.releases, Version{}), complementIntervals(r.preReleases, minVersion))
+}
+
+// String implements the fmt.Stringer interface and returns the canonical
+// form of the range. Release and pre-release intervals are listed separately
+// and joined with "∪"; an empty range is "∅".
+//
+// Examples:
+//   - MustParseConstraint("^1.2.3").Range() is "releases [1.2.3, 2.0.0)"
+//   - MustParseConstraint("^1.2.3-beta").Range() is "releases [1.2.3, 2.0.0); pre-releases [1.2.3-beta, 1.2.4-0)"
+func (r Range) String() string {
+	if r.IsEmpty() {
+		return "∅"
+	}
+	var parts []string
+	if len(r.releases) != 0 {
+		parts = append(parts, "releases "+joinIntervals(r.releases))
+	}
+	if len(r.preReleases) != 0 {
+		parts = append(parts, "pre-releases "+joinIntervals(r.preReleases))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func joinIntervals(list []Interval) string {
+	s := make([]string, len(list))
+	for i, iv := range list {
+		s[i] = iv.String()
+	}
+	return strings.Join(s, " ∪ ")
+}
+
+// successor returns the lowest version with higher precedence than v.
+// The successor of a release is the first pre-release of the next patch,
+// and the successor of a pre-release appends the lowest identifier, "0".
+func successor(v Version) Version {
+	if v.PreRelease != "" {
+		return Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, PreRelease: v.PreRelease + ".0"}
+	}
+	return Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1, PreRelease: "0"}
+}
+
+// releaseCeil returns the lowest release with precedence at least that of v.
+func releaseCeil(v Version) Version {
+	return Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+}
+
+// preReleaseCeil returns the lowest pre-release with precedence at least that of v.
+func preReleaseCeil(v Version) Version {
+	if v.PreRelease != "" {
+		return stripBuild(v)
+	}
+	return successor(v)
+}
+
+// stripBuild returns v without build metadata.
+func stripBuild(v Version) Version {
+	v.Build = ""
+	return v
+}
+
+// compareUpper compares two upper bounds, treating unbounded as the highest.
+func compareUpper(a, b Interval) int {
+	switch {
+	case a.Unbounded && b.Unbounded:
+		return 0
+	case a.Unbounded:
+		return 1
+	case b.Unbounded:
+		return -1
+	}
+	return a.Upper.Compare(b.Upper)
+}
+
+// normalizeIntervals moves every bound up to the nearest version that can be a member,
+// using ceil, then drops empty intervals and merges overlapping or adjacent ones.
+// The result is sorted by lower bound.
+func normalizeIntervals(list []Interval, ceil func(Version) Version) []Interval {
+	var out []Interval
+	for _, i := range list {
+		i.Lower = ceil(i.Lower)
+		if i.Unbounded {
+			i.Upper = Version{}
+		} else {
+			if i.Upper = ceil(i.Upper); i.Lower.Compare(i.Upper) >= 0 {
+				continue
+			}
+		}
+		out = append(out, i)
+	}
+	slices.SortFunc(out, func(a, b Interval) int {
+		return a.Lower.Compare(b.Lower)
+	})
+	var merged []Interval
+	for _, i := range out {
+		if n := len(merged); n > 0 && (merged[n-1].Unbounded || i.Lower.Compare(merged[n-1].Upper) <= 0) {
+			if compareUpper(i, merged[n-1]) > 0 {
+				merged[n-1].Upper, merged[n-1].Unbounded = i.Upper, i.Unbounded
+			}
+			continue
+		}
+		merged = append(merged, i)
+	}
+	return merged
+}
+
+// intersectIntervals returns the intersection of two sorted, disjoint interval lists.
+func intersectIntervals(a, b []Interval) []Interval {
+	var out []Interval
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		lower := a[i].Lower
+		if b[j].Lower.Compare(lower) > 0 {
+			lower = b[j].Lower
+		}
+		upper := a[i]
+		if compareUpper(b[j], a[i]) < 0 {
+			upper = b[j]
+		}
+		if upper.Unbounded || lower.Compare(upper.Upper) < 0 {
+			out = append(out, Interval{Lower: lower, Upper: upper.Upper, Unbounded: upper.Unbounded})
+		}
+		if compareUpper(a[i], b[j]) < 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return out
+}
+
+// complementIntervals returns the gaps in a sorted, disjoint interval list,
+// starting from the lowest member min.
+func complementIntervals(list []Interval, min Version) []Interval {
+	var out []Interval
+	lower := min
+	for _, i := range list {
+		if lower.Compare(i.Lower) < 0 {
+			out = append(out, Interval{Lower: lower, Upper: i.Lower})
+		}
+		if i.Unbounded {
+			return out
+		}
+		lower = i.Upper
+	}
+	return append(out, Interval{Lower: lower, Unbounded: true})
+}
+
+// equalIntervals reports whether two normalized interval lists are the same.
+func equalIntervals(a, b []Interval) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Lower.Equal(b[i].Lower) || a[i].Unbounded != b[i].Unbounded || (!a[i].Unbounded && !a[i].Upper.Equal(b[i].Upper)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// rangeTestConstraints is a set of constraints that exercises every kind of comparator.
+var rangeTestConstraints = []string{
+	"*", "^1.2", "^1.2.3", "~1.2.3", "^0.0.3", ">=2.0", "1.x || >=2.5.0", "<1.0.0-rc.1",
+	">1.2.3 <1.2.4", "^1.2.3-beta.2", "1.2.3 - 2.3.4", "<=1.0.0 || >=3", "=1.0.0",
+	">1.0.0-alpha <1.0.0-beta.1", ">=0.0.0-0", "<0.0.0-0", "1.0.0-rc.1 || 1.0.0-rc.2",
+	">=1.2.3-alpha <1.2.3 || ^2.0.0-rc.1", "2.x >1.0.0-rc.1", ">=1.2.3 <2.0.0",
+}
+
+// rangeTestVersions returns a dense set of versions around the bounds used in rangeTestConstraints.
+func rangeTestVersions() []semver.Version {
+	var versions []semver.Version
+	for major := 0; major <= 4; major++ {
+		for minor := 0; minor <= 3; minor++ {
+			for patch := 0; patch <= 4; patch++ {
+				for _, pre := range []string{"", "0", "alpha", "alpha.0", "beta", "beta.1", "beta.2", "beta.3", "rc.1", "rc.1.0", "rc.2", "rc.10"} {
+					versions = append(versions, semver.Version{Major: major, Minor: minor, Patch: patch, PreRelease: pre})
+				}
+			}
+		}
+	}
+	return versions
+}
+
+// Test that Constraint.Range contains exactly the versions that Check accepts
+func TestConstraintRange(t *testing.T) {
+	versions := rangeTestVersions()
+	for _, s := range rangeTestConstraints {
+		for _, include := range []bool{false, true} {
+			c := semver.MustParseConstraint(s)
+			c.IncludePreRelease = include
+			r := c.Range()
+			for _, v := range versions {
+				if r.Contains(v) != c.Check(v) {
+					t.Errorf("%q (include %v): Range %s Contains(%s) = %v, Check = %v", s, include, r, v, r.Contains(v), c.Check(v))
+				}
+			}
+		}
+	}
+}
+
+// Test that Intersect, Union and Complement agree with Contains
+func TestRangeAlgebra(t *testing.T) {
+	versions := rangeTestVersions()
+	for _, s1 := range rangeTestConstraints {
+		r1 := semver.MustParseConstraint(s1).Range()
+		complement := r1.Complement()
+		if !complement.Complement().Equal(r1) {
+			t.Errorf("%q: double complement %s is not %s", s1, complement.Complement(), r1)
+		}
+		for _, s2 := range rangeTestConstraints {
+			r2 := semver.MustParseConstraint(s2).Range()
+			intersect, union := r1.Intersect(r2), r1.Union(r2)
+			for _, v := range versions {
+				in1, in2 := r1.Contains(v), r2.Contains(v)
+				if intersect.Contains(v) != (in1 && in2) {
+					t.Errorf("%q and %q: Intersect %s Contains(%s) = %v", s1, s2, intersect, v, intersect.Contains(v))
+				}
+				if union.Contains(v) != (in1 || in2) {
+					t.Errorf("%q or %q: Union %s Contains(%s) = %v", s1, s2, union, v, union.Contains(v))
+				}
+				if complement.Contains(v) == in1 {
+					t.Errorf("not %q: Complement %s Contains(%s) = %v", s1, complement, v, complement.Contains(v))
+				}
+			}
+		}
+	}
+}
+
+// Test for Range.IsEmpty
+func TestRangeIsEmpty(t *testing.T) {
+	testCases := []struct {
+		constraints []string
+		expected    bool
+	}{
+		{[]string{"^1.2", ">=2.0"}, true},
+		{[]string{"^1.2", ">=1.9"}, false},
+		{[]string{"~1.2.3", "^1.2.3"}, false},
+		{[]string{">1.2.3 <1.2.4"}, true},
+		{[]string{"<0.0.0-0"}, true},
+		{[]string{"1.x || 3.x", "2.x"}, true},
+		{[]string{"1.x || 3.x", "2.x || 3.1.4"}, false},
+		{[]string{"^1.2.3-beta.2", "<1.2.3"}, true}, // "<1.2.3" admits no pre-releases
+		{[]string{"^1.2.3-beta.2", "<1.2.3-rc"}, false},
+		{[]string{"^1.2.3-beta.2", "<1.2.3-beta.2"}, true},
+	}
+
+	for _, tc := range testCases {
+		r := semver.FullRange()
+		for _, s := range tc.constraints {
+			r = r.Intersect(semver.MustParseConstraint(s).Range())
+		}
+		if r.IsEmpty() != tc.expected {
+			t.Errorf("%q: IsEmpty() = %v, expected %v (%s)", tc.constraints, r.IsEmpty(), tc.expected, r)
+		}
+	}
+}
+
+// Test for Range.String canonical form
+func TestRangeString(t *testing.T) {
+	testCases := []struct {
+		constraint string
+		expected   string
+	}{
+		{"^1.2.3", "releases [1.2.3, 2.0.0)"},
+		{"^1.2.3-beta", "releases [1.2.3, 2.0.0); pre-releases [1.2.3-beta, 1.2.4-0)"},
+		{"*", "releases [0.0.0, ∞)"},
+		{"<0.0.0-0", "∅"},
+		{"1.x || >=1.5.0 <3", "releases [1.0.0, 3.0.0)"},
+		{"<=1.0.0 || >=1.0.1", "releases [0.0.0, ∞)"},
+		{"1.0.0 || 1.0.1 || 1.0.2", "releases [1.0.0, 1.0.3)"},
+		{">=1.0.0-rc.1 <=1.0.0-rc.2", "pre-releases [1.0.0-rc.1, 1.0.0-rc.2.0)"},
+	}
+
+	for _, tc := range testCases {
+		if actual := semver.MustParseConstraint(tc.constraint).Range().String(); actual != tc.expected {
+			t.Errorf("%q: expected %q, actual %q", tc.constraint, tc.expected, actual)
+		}
+	}
+	if actual := semver.FullRange().Complement().String(); actual != "∅" {
+		t.Errorf("Complement of FullRange: expected %q, actual %q", "∅", actual)
+	}
+}
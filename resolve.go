@@ -0,0 +1,180 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+)
+
+// Source identifies where Resolve found a version.
+type Source int
+
+const (
+	SourceLDFlags   Source = iota // a string injected with -ldflags "-X main.version=..."
+	SourceFile                    // a VERSION file, usually embedded with embed.FS
+	SourceBuildInfo               // the main module version from the build information
+)
+
+// String implements the fmt.Stringer interface.
+func (s Source) String() string {
+	switch s {
+	case SourceLDFlags:
+		return "ldflags"
+	case SourceFile:
+		return "file"
+	case SourceBuildInfo:
+		return "buildinfo"
+	}
+	return fmt.Sprintf("Source(%d)", int(s))
+}
+
+// ResolveOptions lists the places Resolve looks for a version.
+// The zero value only consults the build information.
+type ResolveOptions struct {
+	// LDFlags is the string injected at link time. An empty string means
+	// nothing was injected.
+	LDFlags string
+	// FS holds the version file, such as an embed.FS. Nil skips the file.
+	FS fs.FS
+	// File is the path of the version file in FS. The default is "VERSION".
+	File string
+	// BuildInfo supplies the build information. The default is DefaultBuildInfoSource.
+	BuildInfo BuildInfoSource
+	// Order is the precedence of the sources, highest first. Sources that
+	// are left out are not consulted. The default is ldflags, file, build info.
+	Order []Source
+	// Commit sets the build metadata of the resolved version to the Commit
+	// of the build information, if the version has no build metadata.
+	Commit bool
+}
+
+// SourceResult is what Resolve found in one source.
+type SourceResult struct {
+	Source  Source
+	Found   bool    // the source had a value; an empty ldflags string or a missing file is not found
+	Value   string  // the raw value, as injected or read
+	Version Version // the parsed value, if Err is nil
+	Err     error   // the error reading or parsing the value
+}
+
+// Resolution is the result of Resolve.
+type Resolution struct {
+	Version Version        // the resolved version
+	Source  Source         // the source that provided Version
+	Results []SourceResult // every source consulted, in order of precedence
+}
+
+// Err returns the errors from every source that had a value that could not
+// be read or parsed, joined with errors.Join, or nil if there were none.
+// A source that fails does not stop Resolve from using a lower one.
+func (r Resolution) Err() error {
+	var errs []error
+	for _, result := range r.Results {
+		if result.Err != nil {
+			errs = append(errs, result.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Resolve returns the version from the highest precedence source that has a
+// valid one, along with a report of every source it consulted. The ldflags
+// string and the file contents are trimmed of surrounding white space and a
+// leading "v" before being parsed with Parse. The build information gives
+// the version described by BuildInfo.Version, which is always found for
+// a binary with build information, so it belongs at the end of Order.
+//
+// It returns an error if no source has a valid version; the error includes
+// the errors from any sources that failed.
+//
+// Example:
+//
+//	var version string // set with -ldflags "-X main.version=1.4.2"
+//
+//	//go:embed VERSION
+//	var files embed.FS
+//
+//	r, err := semver.Resolve(semver.ResolveOptions{LDFlags: version, FS: files, Commit: true})
+//	// r.Version is 1.4.2+5114f85 and r.Source is SourceLDFlags
+func Resolve(opts ResolveOptions) (Resolution, error) {
+	order := opts.Order
+	if order == nil {
+		order = []Source{SourceLDFlags, SourceFile, SourceBuildInfo}
+	}
+	src := opts.BuildInfo
+	if src == nil {
+		src = DefaultBuildInfoSource
+	}
+	info, hasInfo := ReadBuildInfoFrom(src)
+
+	var r Resolution
+	winner := -1
+	for _, source := range order {
+		result := SourceResult{Source: source}
+		switch source {
+		case SourceLDFlags:
+			result.Found, result.Value = opts.LDFlags != "", opts.LDFlags
+			if result.Found {
+				result.Version, result.Err = resolveText(source, opts.LDFlags)
+			}
+		case SourceFile:
+			if opts.FS == nil {
+				break
+			}
+			name := opts.File
+			if name == "" {
+				name = "VERSION"
+			}
+			data, err := fs.ReadFile(opts.FS, name)
+			if errors.Is(err, fs.ErrNotExist) {
+				break
+			}
+			result.Found, result.Value = true, string(data)
+			if err != nil {
+				result.Err = fmt.Errorf("semver: %s: %w", source, err)
+			} else {
+				result.Version, result.Err = resolveText(source, result.Value)
+			}
+		case SourceBuildInfo:
+			if !hasInfo {
+				break
+			}
+			result.Found, result.Value = true, info.MainModule.Version
+			if v, err := info.Version(); err != nil {
+				result.Err = fmt.Errorf("semver: %s: %w", source, err)
+			} else {
+				result.Version = v
+			}
+		default:
+			return r, fmt.Errorf("semver: unknown version source %v", source)
+		}
+		if winner == -1 && result.Found && result.Err == nil {
+			winner = len(r.Results)
+		}
+		r.Results = append(r.Results, result)
+	}
+
+	if winner == -1 {
+		if err := r.Err(); err != nil {
+			return r, fmt.Errorf("semver: no valid version found: %w", err)
+		}
+		return r, fmt.Errorf("semver: no version found")
+	}
+	r.Source, r.Version = r.Results[winner].Source, r.Results[winner].Version
+	if opts.Commit && r.Version.Build == "" && hasInfo {
+		r.Version.Build = info.Commit()
+	}
+	return r, nil
+}
+
+// resolveText parses a version injected with ldflags or read from a file.
+func resolveText(source Source, s string) (Version, error) {
+	v, err := Parse(strings.TrimPrefix(strings.TrimSpace(s), "v"))
+	if err != nil {
+		return Version{}, fmt.Errorf("semver: %s: %w", source, err)
+	}
+	return v, nil
+}
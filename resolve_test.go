@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for Resolve
+func TestResolve(t *testing.T) {
+	info, err := semver.ParseBuildInfo(goVersionDump)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	buildInfo := semver.StaticBuildInfo{Info: info}
+	files := fstest.MapFS{
+		"VERSION":     {Data: []byte("v1.3.0-rc.1\n")},
+		"BAD_VERSION": {Data: []byte("1.3\n")},
+	}
+
+	testCases := []struct {
+		name     string
+		opts     semver.ResolveOptions
+		expected string
+		source   semver.Source
+		failed   []semver.Source
+	}{
+		{"ldflags", semver.ResolveOptions{LDFlags: "1.5.0", FS: files, BuildInfo: buildInfo}, "1.5.0", semver.SourceLDFlags, nil},
+		{"file", semver.ResolveOptions{FS: files, BuildInfo: buildInfo}, "1.3.0-rc.1", semver.SourceFile, nil},
+		{"build info", semver.ResolveOptions{BuildInfo: buildInfo}, "1.4.2", semver.SourceBuildInfo, nil},
+		{"missing file", semver.ResolveOptions{FS: files, File: "NOPE", BuildInfo: buildInfo}, "1.4.2", semver.SourceBuildInfo, nil},
+		{"order", semver.ResolveOptions{LDFlags: "1.5.0", FS: files, BuildInfo: buildInfo, Order: []semver.Source{semver.SourceBuildInfo, semver.SourceLDFlags}}, "1.4.2", semver.SourceBuildInfo, nil},
+		{"commit", semver.ResolveOptions{LDFlags: "v1.5.0", BuildInfo: buildInfo, Commit: true}, "1.5.0+5114f85-dirty", semver.SourceLDFlags, nil},
+		{"keep build", semver.ResolveOptions{LDFlags: "1.5.0+ci.42", BuildInfo: buildInfo, Commit: true}, "1.5.0+ci.42", semver.SourceLDFlags, nil},
+		{"bad ldflags", semver.ResolveOptions{LDFlags: "1.5", FS: files, BuildInfo: buildInfo}, "1.3.0-rc.1", semver.SourceFile, []semver.Source{semver.SourceLDFlags}},
+		{"bad file", semver.ResolveOptions{LDFlags: "1.5", FS: files, File: "BAD_VERSION", BuildInfo: buildInfo}, "1.4.2", semver.SourceBuildInfo, []semver.Source{semver.SourceLDFlags, semver.SourceFile}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := semver.Resolve(tc.opts)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if r.Version.String() != tc.expected || r.Source != tc.source {
+				t.Errorf("Expected %s from %v, actual %s from %v", tc.expected, tc.source, r.Version, r.Source)
+			}
+			var failed []semver.Source
+			for _, result := range r.Results {
+				if result.Err != nil {
+					failed = append(failed, result.Source)
+				}
+			}
+			if len(failed) != len(tc.failed) {
+				t.Fatalf("Expected failed sources %v, actual %v", tc.failed, failed)
+			}
+			for i := range failed {
+				if failed[i] != tc.failed[i] {
+					t.Errorf("Expected failed sources %v, actual %v", tc.failed, failed)
+				}
+			}
+			var perr *semver.ParseError
+			if len(tc.failed) != 0 && !errors.As(r.Err(), &perr) {
+				t.Errorf("Expected *ParseError in %v", r.Err())
+			}
+		})
+	}
+}
+
+// Test for Resolve when no source has a valid version
+func TestResolveError(t *testing.T) {
+	r, err := semver.Resolve(semver.ResolveOptions{LDFlags: "1.5", BuildInfo: semver.StaticBuildInfo{}})
+	var perr *semver.ParseError
+	if !errors.As(err, &perr) {
+		t.Errorf("Expected *ParseError, got %v", err)
+	}
+	if len(r.Results) != 3 || !r.Results[0].Found || r.Results[1].Found || r.Results[2].Found {
+		t.Errorf("Unexpected results %+v", r.Results)
+	}
+	if _, err := semver.Resolve(semver.ResolveOptions{BuildInfo: semver.StaticBuildInfo{}}); err == nil {
+		t.Errorf("Expected error when no source has a version")
+	}
+	if _, err := semver.Resolve(semver.ResolveOptions{Order: []semver.Source{semver.Source(9)}}); err == nil {
+		t.Errorf("Expected error for unknown source")
+	}
+}
@@ -6,7 +6,7 @@ package semver
 
 import (
 	"fmt"
-	"strconv"
+	"slices"
 	"strings"
 )
 
@@ -102,6 +102,14 @@ func (v ByVersion) Swap(i, j int) {
 	v[i], v[j] = v[j], v[i]
 }
 
+// Sort sorts a slice of versions in increasing order of precedence.
+// Unlike sort.Sort(ByVersion(versions)), which must box the slice in an
+// interface, Sort does not allocate. Versions with the same precedence
+// may be reordered; use slices.SortStableFunc with Compare to keep their order.
+func Sort(versions []Version) {
+	slices.SortFunc(versions, Version.Compare)
+}
+
 // Equal returns true if this version is identical to v2 in all components.
 // This includes major, minor, patch, pre-release, and build metadata.
 // Note: Two versions that differ only in build metadata are considered different
@@ -124,10 +132,13 @@ func (v Version) Equal(v2 Version) bool {
 //  4. Pre-release version (when major.minor.patch are equal)
 //     - Normal version (no pre-release) has higher precedence than pre-release
 //     - Pre-release identifiers are compared lexically in ASCII sort order
-//     - Numeric identifiers are compared numerically (1 < 2 < 10)
+//     - Numeric identifiers are compared numerically (1 < 2 < 10), whatever their length
 //     - Numeric identifiers have lower precedence than non-numeric (1 < alpha)
 //     - Larger set of pre-release fields has higher precedence (1.0.0-alpha < 1.0.0-alpha.1)
 //
+// When the numbers are equal, the result is v.PreReleaseIdentifiers().Compare(v2.PreReleaseIdentifiers()),
+// which Compare computes without splitting the strings.
+//
 // Examples: 1.0.0-alpha < 1.0.0-alpha.1 < 1.0.0-alpha.beta < 1.0.0-beta < 1.0.0-beta.2 < 1.0.0-beta.11 < 1.0.0-rc.1 < 1.0.0
 //
 // This method is useful for sorting and integrates well with Go's sort package:
@@ -162,36 +173,57 @@ func (v Version) Compare(v2 Version) int {
 		return -1
 	}
 	// Both have prerelease, compare them
-	fields1 := strings.Split(v.PreRelease, ".")
-	fields2 := strings.Split(v2.PreRelease, ".")
-	for i := 0; i < len(fields1) && i < len(fields2); i++ {
-		n1, err1 := strconv.Atoi(fields1[i])
-		n2, err2 := strconv.Atoi(fields2[i])
-		if err1 == nil && err2 == nil { // both fields are int
-			if n1 < n2 {
-				return -1
-			} else if n1 > n2 {
+	return comparePreRelease(v.PreRelease, v2.PreRelease)
+}
+
+// comparePreRelease compares two non-empty pre-release strings identifier by
+// identifier. It walks the strings in place, so it does not allocate.
+func comparePreRelease(pre1, pre2 string) int {
+	for {
+		id1, rest1, more1 := strings.Cut(pre1, ".")
+		id2, rest2, more2 := strings.Cut(pre2, ".")
+		if cmp := compareIdentifier(id1, id2); cmp != 0 {
+			return cmp
+		}
+		// All common fields are equal, so a larger set of fields has higher precedence
+		if !more1 || !more2 {
+			if more1 {
 				return 1
+			} else if more2 {
+				return -1
 			}
-			// n1 and n2 are equal, so compare the next field
-		} else if err1 == nil { // only field1 is an int
-			return -1 // numeric identifiers have lower precedence than non-numeric
-		} else if err2 == nil { // only field2 is an int
-			return 1  // numeric identifiers have lower precedence than non-numeric
-		} else if fields1[i] < fields2[i] { // compare as text
-			return -1
-		} else if fields1[i] > fields2[i] { // compare as text
-			return 1
+			// Versions have same precedence (build metadata is ignored)
+			return 0
 		}
+		pre1, pre2 = rest1, rest2
+	}
+}
+
+// compareIdentifier compares two pre-release identifiers.
+// Numeric identifiers are compared as decimal strings, by length and then by digits,
+// so identifiers of any length compare correctly without being converted to int.
+func compareIdentifier(id1, id2 string) int {
+	num1, num2 := isNumeric(id1), isNumeric(id2)
+	if num1 && num2 { // both fields are numbers
+		return compareDigits(id1, id2)
+	} else if num1 { // only field1 is a number
+		return -1 // numeric identifiers have lower precedence than non-numeric
+	} else if num2 { // only field2 is a number
+		return 1 // numeric identifiers have lower precedence than non-numeric
 	}
-	// All common fields are equal, compare field lengths
-	if len(fields1) < len(fields2) {
+	return strings.Compare(id1, id2) // compare as text
+}
+
+// compareDigits compares two strings of decimal digits by numeric value.
+// Leading zeros, which Parse rejects but a hand-built Version may contain, are ignored.
+func compareDigits(d1, d2 string) int {
+	d1, d2 = strings.TrimLeft(d1, "0"), strings.TrimLeft(d2, "0")
+	if len(d1) < len(d2) {
 		return -1
-	} else if len(fields1) > len(fields2) {
+	} else if len(d1) > len(d2) {
 		return 1
 	}
-	// Versions have same precedence (build metadata is ignored)
-	return 0
+	return strings.Compare(d1, d2)
 }
 
 // IsZero returns true if the version is 0.0.0.
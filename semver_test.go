@@ -436,3 +436,113 @@ func TestByVersion(t *testing.T) {
 		})
 	}
 }
+
+// Test for Compare with numeric identifiers that don't fit in an int
+func TestCompareLargeIdentifiers(t *testing.T) {
+	testCases := []struct {
+		version1 string
+		version2 string
+		expected int
+	}{
+		{"1.0.0-alpha.99999999999999999999", "1.0.0-alpha.100000000000000000000", -1},
+		{"1.0.0-alpha.99999999999999999999", "1.0.0-alpha.9", 1},
+		{"1.0.0-alpha.99999999999999999999", "1.0.0-alpha.99999999999999999999", 0},
+		{"1.0.0-alpha.99999999999999999999", "1.0.0-alpha.beta", -1},
+		{"1.0.0-99999999999999999998.1", "1.0.0-99999999999999999999", -1},
+		{"1.0.0-alpha.-1", "1.0.0-alpha.1", 1}, // "-1" is alphanumeric, not a negative number
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.version1+" "+tc.version2, func(t *testing.T) {
+			v1, v2 := semver.MustParse(tc.version1), semver.MustParse(tc.version2)
+			if actual := v1.Compare(v2); actual != tc.expected {
+				t.Errorf("Compare: expected %d, actual %d", tc.expected, actual)
+			}
+			if actual := v2.Compare(v1); actual != -tc.expected {
+				t.Errorf("reversed Compare: expected %d, actual %d", -tc.expected, actual)
+			}
+		})
+	}
+}
+
+// benchmarkVersions returns versions that exercise every step of Compare.
+func benchmarkVersions() []semver.Version {
+	var versions []semver.Version
+	for _, s := range []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "2.0.0-rc.1.2.3",
+		"2.0.0-rc.1.2.4", "2.0.0+build", "10.20.30-alpha.99999999999999999999",
+		"1234.5678.9012",
+	} {
+		versions = append(versions, semver.MustParse(s))
+	}
+	return versions
+}
+
+// Test that Compare, Less and Sort don't allocate
+func TestCompareAllocations(t *testing.T) {
+	versions := benchmarkVersions()
+	buf := make([]semver.Version, len(versions))
+	for name, fn := range map[string]func(){
+		"Compare": func() {
+			for _, v := range versions {
+				for _, w := range versions {
+					_ = v.Compare(w)
+				}
+			}
+		},
+		"Less": func() {
+			for _, v := range versions {
+				for _, w := range versions {
+					_ = v.Less(w)
+				}
+			}
+		},
+		"Sort": func() {
+			copy(buf, versions)
+			semver.Sort(buf)
+		},
+	} {
+		if allocs := testing.AllocsPerRun(100, fn); allocs != 0 {
+			t.Errorf("%s: expected 0 allocations, actual %v", name, allocs)
+		}
+	}
+}
+
+func BenchmarkCompare(b *testing.B) {
+	versions := benchmarkVersions()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v, w := versions[i%len(versions)], versions[(i/len(versions))%len(versions)]
+		_ = v.Compare(w)
+	}
+}
+
+func BenchmarkLess(b *testing.B) {
+	v1, v2 := semver.MustParse("1.0.0-rc.1.2.3"), semver.MustParse("1.0.0-rc.1.2.4")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = v1.Less(v2)
+	}
+}
+
+func BenchmarkSort(b *testing.B) {
+	versions := benchmarkVersions()
+	buf := make([]semver.Version, len(versions))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(buf, versions)
+		semver.Sort(buf)
+	}
+}
+
+// BenchmarkByVersion allocates once per sort.Sort call, to box the slice, but not per comparison.
+func BenchmarkByVersion(b *testing.B) {
+	versions := benchmarkVersions()
+	buf := make([]semver.Version, len(versions))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(buf, versions)
+		sort.Sort(semver.ByVersion(buf))
+	}
+}
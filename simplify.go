@@ -0,0 +1,259 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dialect selects the syntax that Constraint.Format writes.
+type Dialect int
+
+const (
+	// DialectNPM writes node-semver ranges, using "^", "~" and X-ranges
+	// where they are exact, with sets joined by " || ".
+	DialectNPM Dialect = iota
+	// DialectCargo writes Cargo version requirements, with comparators
+	// joined by ", ". Cargo has no "||", so only one set is allowed.
+	DialectCargo
+	// DialectComparator writes only primitive comparators, with "=" for
+	// exact versions and sets joined by " || ".
+	DialectComparator
+)
+
+// String implements the fmt.Stringer interface.
+func (d Dialect) String() string {
+	switch d {
+	case DialectNPM:
+		return "npm"
+	case DialectCargo:
+		return "cargo"
+	case DialectComparator:
+		return "comparator"
+	}
+	return fmt.Sprintf("Dialect(%d)", int(d))
+}
+
+// Simplify returns the smallest constraint that is satisfied by exactly
+// the same versions as c, using c.Range to remove redundant comparators
+// and merge overlapping sets. The result has the fewest possible sets,
+// and each set has at most two comparators. IncludePreRelease is preserved.
+//
+// Example:
+//   - ">=1.2.0 >=1.3.0 <2.0.0 || ^1.4.0" simplifies to ">=1.3.0 <2.0.0", which Format writes as "^1.3.0"
+func (c Constraint) Simplify() Constraint {
+	if c.IncludePreRelease {
+		return Constraint{Sets: precedenceSets(c), IncludePreRelease: true}
+	}
+	simple, err := c.Range().Constraint()
+	if err != nil {
+		// unreachable: the range of a constraint only admits
+		// pre-releases from the finite set of cores it names
+		return c
+	}
+	return simple
+}
+
+// precedenceSets returns the minimal comparator sets for the versions that
+// satisfy c by precedence alone, which is the meaning of c when
+// IncludePreRelease is set.
+func precedenceSets(c Constraint) [][]Comparator {
+	var list []Interval
+	for _, set := range c.Sets {
+		bounds := []Interval{{Lower: minVersion, Unbounded: true}}
+		for _, cmp := range set {
+			bounds = intersectIntervals(bounds, cmp.intervals())
+		}
+		list = append(list, bounds...)
+	}
+	var sets [][]Comparator
+	for _, i := range normalizeIntervals(list, stripBuild) {
+		sets = append(sets, intervalSet(i, minVersion))
+	}
+	if sets == nil {
+		sets = [][]Comparator{{{Op: OpLess, Version: minVersion}}}
+	}
+	return sets
+}
+
+// intervalSet returns the comparators for an interval, leaving out a lower
+// bound of min and writing single versions as an exact comparator.
+func intervalSet(i Interval, min Version) []Comparator {
+	if !i.Unbounded && i.Upper.Equal(successor(i.Lower)) {
+		return []Comparator{{Op: OpEqual, Version: i.Lower}}
+	}
+	var set []Comparator
+	if !i.Lower.Equal(min) {
+		set = append(set, Comparator{Op: OpGreaterEqual, Version: i.Lower})
+	}
+	if !i.Unbounded {
+		set = append(set, Comparator{Op: OpLess, Version: i.Upper})
+	}
+	if set == nil {
+		set = []Comparator{{Op: OpGreaterEqual, Version: min}}
+	}
+	return set
+}
+
+// Constraint returns the smallest constraint, with the default pre-release
+// rule, whose Range is r. It returns an error if r admits pre-releases of
+// infinitely many major.minor.patch cores, as the complement of a range
+// usually does, because such a range can't be written as a constraint.
+func (r Range) Constraint() (Constraint, error) {
+	// split the pre-release intervals so that each covers a single core
+	var pieces []Interval
+	for _, i := range r.preReleases {
+		for lower := i.Lower; ; {
+			core := releaseCeil(lower)
+			end := successor(core)
+			if !i.Unbounded && i.Upper.Compare(end) <= 0 {
+				pieces = append(pieces, Interval{Lower: lower, Upper: i.Upper})
+				break
+			}
+			if i.Unbounded || i.Upper.Major != core.Major || i.Upper.Minor != core.Minor {
+				return Constraint{}, fmt.Errorf("semver: range %s admits pre-releases of unbounded versions", r)
+			}
+			pieces = append(pieces, Interval{Lower: lower, Upper: end})
+			lower = end
+		}
+	}
+
+	used := make([]bool, len(pieces))
+	var sets [][]Comparator
+	for _, rel := range r.releases {
+		lower, upper := rel.Lower, rel
+		for n, p := range pieces {
+			if used[n] {
+				continue
+			}
+			core := releaseCeil(p.Lower)
+			if core.Equal(rel.Lower) && p.Upper.Equal(successor(core)) {
+				// pre-releases at the top of the lower bound's core
+				lower, used[n] = p.Lower, true
+			} else if !rel.Unbounded && core.Equal(rel.Upper) && p.Lower.Equal(firstPreRelease(core.Major, core.Minor, core.Patch)) && !p.Upper.Equal(successor(core)) {
+				// pre-releases at the bottom of the upper bound's core
+				upper.Upper, used[n] = p.Upper, true
+			}
+		}
+		if lower.Equal(rel.Lower) && upper.Upper.Equal(rel.Upper) && !rel.Unbounded && rel.Upper.Equal(Version{Major: rel.Lower.Major, Minor: rel.Lower.Minor, Patch: rel.Lower.Patch + 1}) {
+			sets = append(sets, []Comparator{{Op: OpEqual, Version: rel.Lower}})
+			continue
+		}
+		upper.Lower = lower
+		sets = append(sets, intervalSet(upper, Version{}))
+	}
+
+	for n, p := range pieces {
+		if used[n] {
+			continue
+		}
+		if p.Upper.Equal(successor(p.Lower)) {
+			sets = append(sets, []Comparator{{Op: OpEqual, Version: p.Lower}})
+			continue
+		}
+		// the lower bound is always written, even for 0.0.0-0, because the
+		// pre-release rule needs a comparator on this core
+		if core := releaseCeil(p.Lower); p.Upper.Equal(successor(core)) {
+			// the whole top of the core; stop below the release
+			p.Upper = core
+		}
+		sets = append(sets, []Comparator{{Op: OpGreaterEqual, Version: p.Lower}, {Op: OpLess, Version: p.Upper}})
+	}
+
+	if sets == nil {
+		sets = [][]Comparator{{{Op: OpLess, Version: minVersion}}}
+	}
+	return Constraint{Sets: sets}, nil
+}
+
+// Format writes the constraint in the given dialect. It does not simplify
+// the constraint first; call Simplify for the shortest output. With
+// IncludePreRelease, only primitive comparators are written, because the
+// sugared forms rely on the default pre-release rule.
+// It returns an error if the constraint can't be written in the dialect,
+// such as a constraint with more than one set in DialectCargo.
+//
+// Examples for MustParseConstraint(">=1.2.3 <2.0.0-0"):
+//   - DialectNPM: "^1.2.3"
+//   - DialectCargo: "^1.2.3"
+//   - DialectComparator: ">=1.2.3 <2.0.0-0"
+func (c Constraint) Format(d Dialect) (string, error) {
+	sep, join := " ", " || "
+	switch d {
+	case DialectNPM, DialectComparator:
+	case DialectCargo:
+		if len(c.Sets) != 1 {
+			return "", fmt.Errorf("semver: cargo requirements can't express %d alternative sets in %q", len(c.Sets), c)
+		}
+		sep = ", "
+	default:
+		return "", fmt.Errorf("semver: unknown dialect %v", d)
+	}
+
+	sets := make([]string, len(c.Sets))
+	for i, set := range c.Sets {
+		if d != DialectComparator && !c.IncludePreRelease {
+			if sugar, ok := formatSugar(set, d); ok {
+				sets[i] = sugar
+				continue
+			}
+		}
+		cmps := make([]string, len(set))
+		for j, cmp := range set {
+			cmps[j] = cmp.Op.String() + cmp.Version.String()
+			if cmp.Op == OpEqual && d == DialectNPM {
+				cmps[j] = cmp.Version.String()
+			}
+		}
+		sets[i] = strings.Join(cmps, sep)
+	}
+	return strings.Join(sets, join), nil
+}
+
+// formatSugar returns the caret, tilde or X-range form of a comparator set,
+// if there is one that desugars to the same versions under the default
+// pre-release rule.
+func formatSugar(set []Comparator, d Dialect) (string, bool) {
+	if len(set) == 1 && set[0].Op == OpGreaterEqual && set[0].Version.IsZero() {
+		return "*", true
+	}
+	if len(set) != 2 || set[0].Op != OpGreaterEqual || set[1].Op != OpLess {
+		return "", false
+	}
+	lower, upper := set[0].Version, set[1].Version
+	if upper.PreRelease != "" && upper.PreRelease != "0" || upper.Build != "" || lower.Build != "" {
+		return "", false
+	}
+	// upper bounds of X.Y.Z and X.Y.Z-0 admit the same versions here: the
+	// pre-releases of X.Y.Z fail "<X.Y.Z-0" by precedence, and fail "<X.Y.Z"
+	// by the pre-release rule because the lower bound is on another core
+	upper = releaseCeil(upper)
+	wildcard := "x"
+	if d == DialectCargo {
+		wildcard = "*"
+	}
+
+	if lower.PreRelease == "" && lower.Minor == 0 && lower.Patch == 0 && upper.Equal(Version{Major: lower.Major + 1}) {
+		return fmt.Sprintf("%d.%s", lower.Major, wildcard), true
+	}
+	if lower.PreRelease == "" && lower.Patch == 0 && upper.Equal(Version{Major: lower.Major, Minor: lower.Minor + 1}) {
+		return fmt.Sprintf("%d.%d.%s", lower.Major, lower.Minor, wildcard), true
+	}
+	var caret Version
+	switch {
+	case lower.Major != 0:
+		caret = Version{Major: lower.Major + 1}
+	case lower.Minor != 0:
+		caret = Version{Minor: lower.Minor + 1}
+	default:
+		caret = Version{Patch: lower.Patch + 1}
+	}
+	if upper.Equal(caret) {
+		return "^" + lower.String(), true
+	}
+	if upper.Equal(Version{Major: lower.Major, Minor: lower.Minor + 1}) {
+		return "~" + lower.String(), true
+	}
+	return "", false
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for Constraint.Simplify and Format
+func TestSimplify(t *testing.T) {
+	testCases := []struct {
+		input      string
+		npm        string
+		cargo      string
+		comparator string
+	}{
+		{">=1.2.0 >=1.3.0 <2.0.0 || ^1.4.0", "^1.3.0", "^1.3.0", ">=1.3.0 <2.0.0"},
+		{"^1.2.3", "^1.2.3", "^1.2.3", ">=1.2.3 <2.0.0"},
+		{"~1.2.3 || 1.2.9", "~1.2.3", "~1.2.3", ">=1.2.3 <1.3.0"},
+		{"1.x || >=1.5.0 <1.7.0", "1.x", "1.*", ">=1.0.0 <2.0.0"},
+		{">=1.2.0 <1.3.0", "1.2.x", "1.2.*", ">=1.2.0 <1.3.0"},
+		{"1.2.3 || 1.2.3", "1.2.3", "=1.2.3", "=1.2.3"},
+		{">=0.0.0 || ^1.0.0", "*", "*", ">=0.0.0"},
+		{"^1.2 >=2", "<0.0.0-0", "<0.0.0-0", "<0.0.0-0"},
+		{"<1.0.0 || >=1.0.0", "*", "*", ">=0.0.0"},
+		{"^1.2.3-beta.2 >=1.2.3-beta.1", "^1.2.3-beta.2", "^1.2.3-beta.2", ">=1.2.3-beta.2 <2.0.0"},
+		{">1.2.3 <1.2.4", "<0.0.0-0", "<0.0.0-0", "<0.0.0-0"},
+		{"1.x || 3.x", "1.x || 3.x", "", ">=1.0.0 <2.0.0 || >=3.0.0 <4.0.0"},
+		{"<2.0.0-rc.1 >=1.0.0", ">=1.0.0 <2.0.0-rc.1", ">=1.0.0, <2.0.0-rc.1", ">=1.0.0 <2.0.0-rc.1"},
+		{"1.2.3-rc.1 || 1.2.3-rc.2", "1.2.3-rc.1 || 1.2.3-rc.2", "", "=1.2.3-rc.1 || =1.2.3-rc.2"},
+		{">=1.0.0-rc.1 <=1.0.0-rc.3 || >=1.0.0-rc.2 <1.0.0", ">=1.0.0-rc.1 <1.0.0", ">=1.0.0-rc.1, <1.0.0", ">=1.0.0-rc.1 <1.0.0"},
+		{">=1.0.0-rc.1 <1.0.0 || ^1.0.0", "^1.0.0-rc.1", "^1.0.0-rc.1", ">=1.0.0-rc.1 <2.0.0"},
+		{"<=1.0.0 || 2.x", "<1.0.1 || 2.x", "", "<1.0.1 || >=2.0.0 <3.0.0"},
+	}
+
+	versions := rangeTestVersions()
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			c := semver.MustParseConstraint(tc.input)
+			simple := c.Simplify()
+			for _, v := range versions {
+				if c.Check(v) != simple.Check(v) {
+					t.Errorf("Simplified %q does not agree with %q for %s", simple, tc.input, v)
+				}
+			}
+			for _, d := range []struct {
+				dialect  semver.Dialect
+				expected string
+			}{
+				{semver.DialectNPM, tc.npm},
+				{semver.DialectCargo, tc.cargo},
+				{semver.DialectComparator, tc.comparator},
+			} {
+				actual, err := simple.Format(d.dialect)
+				if d.expected == "" {
+					if err == nil {
+						t.Errorf("%v: expected error, got %q", d.dialect, actual)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("%v: unexpected error: %v", d.dialect, err)
+				}
+				if actual != d.expected {
+					t.Errorf("%v: expected %q, actual %q", d.dialect, d.expected, actual)
+				}
+			}
+			// the npm and comparator forms must parse back to the same versions
+			for _, d := range []semver.Dialect{semver.DialectNPM, semver.DialectComparator} {
+				s, _ := simple.Format(d)
+				reparsed := semver.MustParseConstraint(s)
+				for _, v := range versions {
+					if c.Check(v) != reparsed.Check(v) {
+						t.Errorf("%v form %q does not agree with %q for %s", d, s, tc.input, v)
+					}
+				}
+			}
+		})
+	}
+}
+
+// Test that Simplify is equivalent for every pair of test constraints
+func TestSimplifyEquivalence(t *testing.T) {
+	versions := rangeTestVersions()
+	for _, s1 := range rangeTestConstraints {
+		for _, s2 := range rangeTestConstraints {
+			for _, include := range []bool{false, true} {
+				c := semver.MustParseConstraint(s1 + " || " + s2)
+				c.IncludePreRelease = include
+				simple := c.Simplify()
+				if simple.IncludePreRelease != include {
+					t.Fatalf("Simplify did not preserve IncludePreRelease")
+				}
+				if len(simple.Sets) > len(c.Sets) {
+					t.Errorf("Simplified %q has more sets than %q", simple, c)
+				}
+				for _, v := range versions {
+					if c.Check(v) != simple.Check(v) {
+						t.Errorf("Simplified %q (include %v) does not agree with %q for %s", simple, include, c, v)
+					}
+				}
+				for _, d := range []semver.Dialect{semver.DialectNPM, semver.DialectComparator} {
+					formatted, err := simple.Format(d)
+					if err != nil {
+						t.Fatalf("Format(%q, %s): unexpected error: %v", simple, d, err)
+					}
+					reparsed, err := semver.ParseConstraint(formatted)
+					if err != nil {
+						t.Fatalf("Format(%q, %s) wrote %q: %v", simple, d, formatted, err)
+					}
+					reparsed.IncludePreRelease = include
+					for _, v := range versions {
+						if c.Check(v) != reparsed.Check(v) {
+							t.Errorf("Formatted %q (%s, include %v) does not agree with %q for %s", formatted, d, include, c, v)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Test for Range.Constraint with a range that can't be written as a constraint
+func TestRangeConstraintError(t *testing.T) {
+	r := semver.MustParseConstraint("^1.2.3").Range().Complement()
+	if c, err := r.Constraint(); err == nil {
+		t.Errorf("Expected error, got %q", c)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Value implements the driver.Valuer interface.
+// The version is stored as its String() form. An invalid version
+// is rejected with the error from Validate.
+//
+// Note that databases sort the string form lexically, so "1.10.0" sorts
+// before "1.9.0". Use OrderedKey when ORDER BY must follow Compare.
+func (v Version) Value() (driver.Value, error) {
+	if err := v.Validate(); err != nil {
+		return nil, err
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts a string or []byte and parses it with Parse.
+func (v *Version) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return v.UnmarshalText([]byte(src))
+	case []byte:
+		return v.UnmarshalText(src)
+	}
+	return fmt.Errorf("semver: cannot scan %T into Version", src)
+}
+
+// OrderedKey is a Version that is stored in a database using EncodeKey
+// instead of String(), so that ORDER BY on the column matches Compare.
+// The column must use a binary (byte-wise) collation.
+//
+// Example:
+//
+//	db.Exec("INSERT INTO releases (version) VALUES (?)", semver.OrderedKey(v))
+//	rows, _ := db.Query("SELECT version FROM releases ORDER BY version")
+//	for rows.Next() {
+//	    var key semver.OrderedKey
+//	    rows.Scan(&key)
+//	}
+type OrderedKey Version
+
+// Value implements the driver.Valuer interface.
+func (k OrderedKey) Value() (driver.Value, error) {
+	return EncodeKey(Version(k))
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts a string or []byte produced by EncodeKey.
+func (k *OrderedKey) Scan(src any) error {
+	var key string
+	switch src := src.(type) {
+	case string:
+		key = src
+	case []byte:
+		key = string(src)
+	default:
+		return fmt.Errorf("semver: cannot scan %T into OrderedKey", src)
+	}
+	v, err := DecodeKey(key)
+	if err != nil {
+		return err
+	}
+	*k = OrderedKey(v)
+	return nil
+}
+
+// Markers used by EncodeKey. Their byte values define the sort order
+// of the parts of a pre-release, so they must not be changed.
+const (
+	keyEndOfPreRelease = '!' // ends a list of pre-release identifiers
+	keyNumeric         = '#' // starts a numeric pre-release identifier
+	keyAlphanumeric    = '%' // starts an alphanumeric pre-release identifier
+	keyEndOfText       = '!' // ends an alphanumeric identifier
+	keyBuild           = '+' // starts the build metadata
+	keyRelease         = '~' // marks a version without a pre-release
+)
+
+// EncodeKey returns a string encoding of v whose byte-wise lexical order
+// matches semantic version precedence: for any valid versions a and b,
+// if a.Compare(b) < 0 then EncodeKey(a) < EncodeKey(b).
+// Versions with the same precedence but different build metadata get
+// distinct keys, so the encoding is lossless and DecodeKey reverses it.
+// An invalid version is rejected with the error from Validate.
+//
+// Numbers are written as the number of digits in the length, the length,
+// and then the digits, so that longer numbers sort after shorter ones.
+// Pre-release identifiers are written with a marker that sorts numeric
+// identifiers before alphanumeric ones, and a version without a pre-release
+// ends with a marker that sorts after every pre-release.
+//
+// Example:
+//   - EncodeKey(MustParse("1.10.0-rc.1")) returns "1111210110%rc!#111!"
+func EncodeKey(v Version) (string, error) {
+	if err := v.Validate(); err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	writeKeyNumber(&sb, strconv.Itoa(v.Major))
+	writeKeyNumber(&sb, strconv.Itoa(v.Minor))
+	writeKeyNumber(&sb, strconv.Itoa(v.Patch))
+	if v.PreRelease == "" {
+		sb.WriteByte(keyRelease)
+	} else {
+		for _, id := range strings.Split(v.PreRelease, ".") {
+			if isNumeric(id) {
+				sb.WriteByte(keyNumeric)
+				writeKeyNumber(&sb, id)
+			} else {
+				sb.WriteByte(keyAlphanumeric)
+				sb.WriteString(id)
+				sb.WriteByte(keyEndOfText)
+			}
+		}
+		sb.WriteByte(keyEndOfPreRelease)
+	}
+	if v.Build != "" {
+		sb.WriteByte(keyBuild)
+		sb.WriteString(v.Build)
+	}
+	return sb.String(), nil
+}
+
+// DecodeKey reverses EncodeKey.
+func DecodeKey(key string) (Version, error) {
+	var v Version
+	var numbers [3]string
+	var ok bool
+	rest := key
+	for i := range numbers {
+		if numbers[i], rest, ok = readKeyNumber(rest); !ok {
+			return Version{}, fmt.Errorf("semver: invalid key %q: bad %s number", key, Component(i))
+		}
+	}
+	var err error
+	if v.Major, err = strconv.Atoi(numbers[0]); err != nil {
+		return Version{}, fmt.Errorf("semver: invalid key %q: %w", key, err)
+	} else if v.Minor, err = strconv.Atoi(numbers[1]); err != nil {
+		return Version{}, fmt.Errorf("semver: invalid key %q: %w", key, err)
+	} else if v.Patch, err = strconv.Atoi(numbers[2]); err != nil {
+		return Version{}, fmt.Errorf("semver: invalid key %q: %w", key, err)
+	}
+
+	if rest != "" && rest[0] == keyRelease {
+		rest = rest[1:]
+	} else {
+		var ids []string
+		for rest != "" && rest[0] != keyEndOfPreRelease {
+			var id string
+			switch rest[0] {
+			case keyNumeric:
+				id, rest, ok = readKeyNumber(rest[1:])
+			case keyAlphanumeric:
+				end := strings.IndexByte(rest, keyEndOfText)
+				ok = end > 1
+				if ok {
+					id, rest = rest[1:end], rest[end+1:]
+				}
+			default:
+				ok = false
+			}
+			if !ok {
+				return Version{}, fmt.Errorf("semver: invalid key %q: bad prerelease", key)
+			}
+			ids = append(ids, id)
+		}
+		if rest == "" || len(ids) == 0 {
+			return Version{}, fmt.Errorf("semver: invalid key %q: bad prerelease", key)
+		}
+		v.PreRelease, rest = strings.Join(ids, "."), rest[1:]
+	}
+
+	if rest != "" {
+		if rest[0] != keyBuild {
+			return Version{}, fmt.Errorf("semver: invalid key %q: unexpected %q", key, rest[0])
+		}
+		v.Build = rest[1:]
+	}
+	if err := v.Validate(); err != nil {
+		return Version{}, fmt.Errorf("semver: invalid key %q: %w", key, err)
+	}
+	return v, nil
+}
+
+// writeKeyNumber writes the digit string n in the length-prefixed form used by EncodeKey.
+func writeKeyNumber(sb *strings.Builder, n string) {
+	length := strconv.Itoa(len(n))
+	sb.WriteByte(byte('0' + len(length)))
+	sb.WriteString(length)
+	sb.WriteString(n)
+}
+
+// readKeyNumber reads a number written by writeKeyNumber from the start of s.
+// It returns the digits and the remainder of s.
+func readKeyNumber(s string) (string, string, bool) {
+	if s == "" || !isDigit(s[0]) || s[0] == '0' {
+		return "", s, false
+	}
+	lenOfLen := int(s[0] - '0')
+	if len(s) < 1+lenOfLen {
+		return "", s, false
+	}
+	length, err := strconv.Atoi(s[1 : 1+lenOfLen])
+	if err != nil || length < 1 || len(s) < 1+lenOfLen+length {
+		return "", s, false
+	}
+	digits := s[1+lenOfLen : 1+lenOfLen+length]
+	if !isNumeric(digits) {
+		return "", s, false
+	}
+	return digits, s[1+lenOfLen+length:], true
+}
+
+// isNumeric reports whether s is a non-empty string of ASCII digits.
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for EncodeKey ordering and DecodeKey round trips
+func TestEncodeKey(t *testing.T) {
+	versions := keyTestVersions()
+	for _, v := range versions {
+		key, err := semver.EncodeKey(v)
+		if err != nil {
+			t.Fatalf("Unexpected error for %v: %v", v, err)
+		}
+		decoded, err := semver.DecodeKey(key)
+		if err != nil {
+			t.Fatalf("Unexpected error decoding %q: %v", key, err)
+		}
+		if !decoded.Equal(v) {
+			t.Errorf("Round trip failed. expected: %v, actual: %v", v, decoded)
+		}
+	}
+	for _, a := range versions {
+		for _, b := range versions {
+			ka, _ := semver.EncodeKey(a)
+			kb, _ := semver.EncodeKey(b)
+			if cmp := a.Compare(b); cmp < 0 && ka >= kb || cmp > 0 && ka <= kb {
+				t.Errorf("Key order does not match Compare for %v (%q) and %v (%q)", a, ka, b, kb)
+			}
+		}
+	}
+}
+
+// Test for DecodeKey with invalid input
+func TestDecodeKeyError(t *testing.T) {
+	for _, key := range []string{"", "111", "111111111", "111111111!", "111111111%!", "111111111#!", "111111111~x", "0111111~"} {
+		if v, err := semver.DecodeKey(key); err == nil {
+			t.Errorf("Expected error for %q, got %v", key, v)
+		}
+	}
+}
+
+// Test for Scanner and Valuer through database/sql with a fake driver
+func TestSQL(t *testing.T) {
+	db, err := sql.Open("semverfake", t.Name())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer db.Close()
+
+	versions := keyTestVersions()
+	rand.New(rand.NewSource(1)).Shuffle(len(versions), func(i, j int) {
+		versions[i], versions[j] = versions[j], versions[i]
+	})
+	for _, v := range versions {
+		if _, err := db.Exec("INSERT", semver.OrderedKey(v), v); err != nil {
+			t.Fatalf("Unexpected error inserting %v: %v", v, err)
+		}
+	}
+
+	rows, err := db.Query("SELECT ORDER BY 1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer rows.Close()
+	var got []semver.Version
+	for rows.Next() {
+		var key semver.OrderedKey
+		var v semver.Version
+		if err := rows.Scan(&key, &v); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !semver.Version(key).Equal(v) {
+			t.Errorf("Key column %v does not match string column %v", semver.Version(key), v)
+		}
+		got = append(got, v)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	sort.Sort(semver.ByVersion(versions))
+	if len(got) != len(versions) {
+		t.Fatalf("Length mismatch. expected: %d, actual: %d", len(versions), len(got))
+	}
+	for i := range versions {
+		if got[i].Compare(versions[i]) != 0 {
+			t.Errorf("Version at index %d mismatch. expected: %s, actual: %s", i, versions[i], got[i])
+		}
+	}
+}
+
+// Test for Scan with unsupported types
+func TestScanError(t *testing.T) {
+	var v semver.Version
+	if err := v.Scan(42); err == nil {
+		t.Errorf("Expected error scanning int")
+	}
+	if err := v.Scan(nil); err == nil {
+		t.Errorf("Expected error scanning NULL")
+	}
+	if err := v.Scan("1.2"); err == nil {
+		t.Errorf("Expected error scanning invalid version")
+	}
+	if _, err := (semver.Version{Major: -1}).Value(); err == nil {
+		t.Errorf("Expected error for invalid version value")
+	}
+}
+
+// keyTestVersions returns a set of versions that exercises every part of the key encoding.
+func keyTestVersions() []semver.Version {
+	var versions []semver.Version
+	for _, s := range []string{
+		"0.0.0", "0.0.1", "0.1.0", "0.9.0", "0.10.0", "1.0.0", "1.0.0+b1", "1.0.0+b2",
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0-rc.1+build.5", "1.0.0-0", "1.0.0-1", "1.0.0-9",
+		"1.0.0-10", "1.0.0-0.a", "1.0.0-a-b", "1.0.0-a", "1.0.0-ab", "1.0.0-A", "1.0.0--",
+		"1.0.0-alpha.-", "2.0.0", "10.0.0", "1234567890.0.0", "1.0.0-1234567890123",
+	} {
+		versions = append(versions, semver.MustParse(s))
+	}
+	return versions
+}
+
+// fakeDriver is an in-memory database/sql driver with a single table.
+// "INSERT" appends a row, and any query returns every row ordered by
+// the byte-wise value of the first column, like a binary collation.
+type fakeDriver struct {
+	sync.Mutex
+	tables map[string][][]driver.Value
+}
+
+func init() {
+	sql.Register("semverfake", &fakeDriver{tables: map[string][][]driver.Value{}})
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{driver: d, name: name}, nil
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+	name   string
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, fmt.Errorf("transactions not supported") }
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error { return nil }
+
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if !strings.HasPrefix(s.query, "INSERT") {
+		return nil, fmt.Errorf("unsupported statement %q", s.query)
+	}
+	s.conn.driver.Lock()
+	defer s.conn.driver.Unlock()
+	s.conn.driver.tables[s.conn.name] = append(s.conn.driver.tables[s.conn.name], args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.conn.driver.Lock()
+	defer s.conn.driver.Unlock()
+	rows := append([][]driver.Value(nil), s.conn.driver.tables[s.conn.name]...)
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i][0].(string) < rows[j][0].(string)
+	})
+	return &fakeRows{rows: rows}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return []string{"key", "version"} }
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// TaggedVersion is a version tag with a path prefix, as used for Go modules
+// in subdirectories of a repository: the tag "services/api/v1.4.0" has the
+// prefix "services/api" and the version 1.4.0. A tag without a prefix, such
+// as "v1.4.0", belongs to the module at the root of the repository.
+//
+// See https://go.dev/ref/mod#vcs-version.
+type TaggedVersion struct {
+	Prefix  string  // the slash-separated directory, without a trailing "/"; "" for the repository root
+	Version Version // the version after the "v"
+}
+
+// ParseTaggedVersion parses a tag of the form "prefix/vX.Y.Z", where the
+// prefix is optional. The version must start with "v", as Go module tags
+// do. It returns an error if the prefix has empty, "." or ".." elements,
+// or if the version is invalid, in which case the error wraps a *ParseError.
+//
+// Examples:
+//   - ParseTaggedVersion("services/api/v1.4.0") returns {"services/api", 1.4.0}
+//   - ParseTaggedVersion("v2.0.0-rc.1") returns {"", 2.0.0-rc.1}
+//   - ParseTaggedVersion("services/api/1.4.0") returns an error for the missing "v"
+func ParseTaggedVersion(tag string) (TaggedVersion, error) {
+	var t TaggedVersion
+	name := tag
+	if i := strings.LastIndexByte(tag, '/'); i != -1 {
+		t.Prefix, name = tag[:i], tag[i+1:]
+		for _, elem := range strings.Split(t.Prefix, "/") {
+			if elem == "" || elem == "." || elem == ".." {
+				return TaggedVersion{}, fmt.Errorf("semver: tag %q: invalid prefix %q", tag, t.Prefix)
+			}
+		}
+	}
+	s, ok := strings.CutPrefix(name, "v")
+	if !ok {
+		return TaggedVersion{}, fmt.Errorf("semver: tag %q: version %q must start with \"v\"", tag, name)
+	}
+	v, err := Parse(s)
+	if err != nil {
+		return TaggedVersion{}, fmt.Errorf("semver: tag %q: %w", tag, err)
+	}
+	t.Version = v
+	return t, nil
+}
+
+// MustParseTaggedVersion is like ParseTaggedVersion but panics if tag is invalid.
+func MustParseTaggedVersion(tag string) TaggedVersion {
+	t, err := ParseTaggedVersion(tag)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// String returns the tag, such as "services/api/v1.4.0".
+func (t TaggedVersion) String() string {
+	if t.Prefix == "" {
+		return "v" + t.Version.String()
+	}
+	return t.Prefix + "/v" + t.Version.String()
+}
+
+// Compare orders tags by prefix, as strings, and then by the precedence of
+// their versions. It returns -1, 0 or 1.
+func (t TaggedVersion) Compare(o TaggedVersion) int {
+	if c := strings.Compare(t.Prefix, o.Prefix); c != 0 {
+		return c
+	}
+	return t.Version.Compare(o.Version)
+}
+
+// ByTaggedVersion implements sort.Interface for []TaggedVersion, grouping
+// the tags by prefix and sorting each group by version precedence.
+type ByTaggedVersion []TaggedVersion
+
+// Len returns the number of tags in the slice.
+func (t ByTaggedVersion) Len() int {
+	return len(t)
+}
+
+// Less reports whether the tag at index i should sort before the tag at index j.
+func (t ByTaggedVersion) Less(i, j int) bool {
+	return t[i].Compare(t[j]) < 0
+}
+
+// Swap swaps the tags at indices i and j.
+func (t ByTaggedVersion) Swap(i, j int) {
+	t[i], t[j] = t[j], t[i]
+}
+
+// CheckModulePath returns an error if the Go module modulePath may not be
+// tagged with t's version, following the major version suffix rules of
+// https://go.dev/ref/mod#major-version-suffixes:
+//
+//   - a path ending in /vN, such as "example.com/api/v2", only carries vN.x.y
+//   - a path without a suffix only carries v0.x.y and v1.x.y, or vN.x.y+incompatible
+//     for N >= 2 when the module has no go.mod file
+//
+// It also returns an error for a suffix of /v0, /v1 or /v02, which Go
+// rejects. The prefix of t is not checked; see ModuleTagPrefix.
+func (t TaggedVersion) CheckModulePath(modulePath string) error {
+	_, suffix, err := splitMajorSuffix(modulePath)
+	if err != nil {
+		return err
+	}
+	major := strconv.Itoa(t.Version.Major)
+	incompatible := t.Version.Build == "incompatible"
+	switch {
+	case suffix != "" && incompatible:
+		return fmt.Errorf("semver: %s: +incompatible is not allowed for module %s", t, modulePath)
+	case suffix != "" && major != suffix:
+		return fmt.Errorf("semver: %s: module %s only carries v%s.x.y tags", t, modulePath, suffix)
+	case suffix == "" && incompatible && (major == "0" || major == "1"):
+		return fmt.Errorf("semver: %s: +incompatible requires major version 2 or later", t)
+	case suffix == "" && !incompatible && major != "0" && major != "1":
+		return fmt.Errorf("semver: %s: module %s needs the suffix /v%s, or +incompatible without a go.mod file", t, modulePath, major)
+	}
+	return nil
+}
+
+// ModuleTagPrefix returns the tag prefix of the Go module modulePath in the
+// repository whose root module path is repoPath: the directory of the
+// module without its major version suffix. The tags of module
+// "example.com/repo/services/api/v2" in repository "example.com/repo" are
+// "services/api/v2.x.y", whether the module lives in services/api or in
+// services/api/v2.
+//
+// It returns an error if modulePath is not repoPath or below it.
+func ModuleTagPrefix(repoPath, modulePath string) (string, error) {
+	path, _, err := splitMajorSuffix(modulePath)
+	if err != nil {
+		return "", err
+	}
+	repoPath = strings.TrimSuffix(repoPath, "/")
+	if path == repoPath {
+		return "", nil
+	} else if dir, ok := strings.CutPrefix(path, repoPath+"/"); ok && repoPath != "" {
+		return dir, nil
+	}
+	return "", fmt.Errorf("semver: module %s is not in repository %s", modulePath, repoPath)
+}
+
+// splitMajorSuffix splits a Go module path into the path without its
+// major version suffix and the major version of the suffix, such as
+// "example.com/api" and "2" for "example.com/api/v2". The major version
+// is "" for a path without a suffix.
+func splitMajorSuffix(modulePath string) (string, string, error) {
+	i := strings.LastIndexByte(modulePath, '/')
+	last := modulePath[i+1:]
+	digits, ok := strings.CutPrefix(last, "v")
+	if i == -1 || !ok || digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return modulePath, "", nil
+	}
+	if digits[0] == '0' || digits == "1" {
+		return "", "", fmt.Errorf("semver: module %s: invalid major version suffix /%s", modulePath, last)
+	}
+	return modulePath[:i], digits, nil
+}
+
+// TagStream is the versions tagged with one prefix, such as the releases
+// of one module of a monorepo.
+type TagStream struct {
+	Prefix   string    // the prefix shared by the tags
+	Versions []Version // the versions, in order of precedence, lowest first
+}
+
+// GroupByPrefix splits tags into one stream per prefix, keyed by prefix.
+// Versions with the same precedence keep their order in tags.
+func GroupByPrefix(tags []TaggedVersion) map[string]TagStream {
+	streams := make(map[string]TagStream)
+	for _, t := range tags {
+		s := streams[t.Prefix]
+		s.Prefix = t.Prefix
+		s.Versions = append(s.Versions, t.Version)
+		streams[t.Prefix] = s
+	}
+	for _, s := range streams {
+		slices.SortStableFunc(s.Versions, Version.Compare)
+	}
+	return streams
+}
+
+// ForModule returns the versions of the stream that the Go module
+// modulePath may carry, as decided by TaggedVersion.CheckModulePath. It
+// separates "example.com/api" from "example.com/api/v2" when both are
+// tagged with the same prefix.
+func (s TagStream) ForModule(modulePath string) TagStream {
+	kept := TagStream{Prefix: s.Prefix}
+	for _, v := range s.Versions {
+		if (TaggedVersion{Prefix: s.Prefix, Version: v}).CheckModulePath(modulePath) == nil {
+			kept.Versions = append(kept.Versions, v)
+		}
+	}
+	return kept
+}
+
+// Latest returns the highest tag of the stream, release or pre-release.
+// The boolean is false if the stream is empty.
+func (s TagStream) Latest() (TaggedVersion, bool) {
+	if len(s.Versions) == 0 {
+		return TaggedVersion{}, false
+	}
+	return TaggedVersion{Prefix: s.Prefix, Version: s.Versions[len(s.Versions)-1]}, true
+}
+
+// LatestRelease returns the highest tag of the stream without a pre-release.
+// The boolean is false if there is none.
+func (s TagStream) LatestRelease() (TaggedVersion, bool) {
+	for i := len(s.Versions) - 1; i >= 0; i-- {
+		if s.Versions[i].PreRelease == "" {
+			return TaggedVersion{Prefix: s.Prefix, Version: s.Versions[i]}, true
+		}
+	}
+	return TaggedVersion{}, false
+}
+
+// Next returns the tag that follows the highest tag of the stream, as
+// computed by Version.Bump, or that follows 0.0.0 when the stream is empty.
+// The first tag of a module with a major version suffix is not known from
+// the prefix alone; check the result with CheckModulePath.
+func (s TagStream) Next(kind BumpKind, opts BumpOptions) (TaggedVersion, error) {
+	latest, _ := s.Latest()
+	next, err := latest.Version.Bump(kind, opts)
+	if err != nil {
+		return TaggedVersion{}, err
+	}
+	return TaggedVersion{Prefix: s.Prefix, Version: next}, nil
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for ParseTaggedVersion and TaggedVersion.String
+func TestParseTaggedVersion(t *testing.T) {
+	testCases := []struct {
+		tag     string
+		prefix  string
+		version string
+		error   string
+	}{
+		{"v1.4.0", "", "1.4.0", ""},
+		{"services/api/v1.4.0", "services/api", "1.4.0", ""},
+		{"tools/v2.0.0-rc.1+sha.5114f85", "tools", "2.0.0-rc.1+sha.5114f85", ""},
+		{"services/api/1.4.0", "", "", `version "1.4.0" must start with "v"`},
+		{"1.4.0", "", "", `must start with "v"`},
+		{"services/api/", "", "", `must start with "v"`},
+		{"/api/v1.0.0", "", "", `invalid prefix "/api"`},
+		{"services//api/v1.0.0", "", "", "invalid prefix"},
+		{"../api/v1.0.0", "", "", "invalid prefix"},
+		{"api/v1.02.0", "", "", "invalid minor"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.tag, func(t *testing.T) {
+			tv, err := semver.ParseTaggedVersion(tc.tag)
+			if tc.error != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.error) {
+					t.Fatalf("Expected error containing %q, actual %v", tc.error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tv.Prefix != tc.prefix || tv.Version.String() != tc.version {
+				t.Errorf("Expected %q and %s, actual %q and %s", tc.prefix, tc.version, tv.Prefix, tv.Version)
+			}
+			if tv.String() != tc.tag {
+				t.Errorf("String: expected %q, actual %q", tc.tag, tv)
+			}
+		})
+	}
+
+	var perr *semver.ParseError
+	if _, err := semver.ParseTaggedVersion("api/v1.x"); !errors.As(err, &perr) {
+		t.Errorf("Expected a *ParseError, actual %v", err)
+	}
+}
+
+// Test for ByTaggedVersion
+func TestByTaggedVersion(t *testing.T) {
+	var tags []semver.TaggedVersion
+	for _, s := range []string{"services/api/v1.10.0", "v2.0.0", "services/api/v1.9.0", "cli/v0.1.0", "v1.0.0", "services/api/v1.10.0-rc.1"} {
+		tags = append(tags, semver.MustParseTaggedVersion(s))
+	}
+	sort.Sort(semver.ByTaggedVersion(tags))
+
+	expected := []string{"v1.0.0", "v2.0.0", "cli/v0.1.0", "services/api/v1.9.0", "services/api/v1.10.0-rc.1", "services/api/v1.10.0"}
+	for i, e := range expected {
+		if tags[i].String() != e {
+			t.Errorf("Position %d: expected %s, actual %s", i, e, tags[i])
+		}
+	}
+}
+
+// Test for TaggedVersion.CheckModulePath
+func TestCheckModulePath(t *testing.T) {
+	testCases := []struct {
+		tag    string
+		module string
+		error  string
+	}{
+		{"v0.3.0", "example.com/repo", ""},
+		{"v1.4.0", "example.com/repo", ""},
+		{"v2.0.0+incompatible", "example.com/repo", ""},
+		{"v2.0.0", "example.com/repo", "needs the suffix /v2"},
+		{"v1.0.0+incompatible", "example.com/repo", "requires major version 2"},
+		{"api/v2.1.0", "example.com/repo/api/v2", ""},
+		{"api/v2.1.0-rc.1", "example.com/repo/api/v2", ""},
+		{"api/v1.4.0", "example.com/repo/api/v2", "only carries v2.x.y tags"},
+		{"api/v3.0.0", "example.com/repo/api/v2", "only carries v2.x.y tags"},
+		{"api/v2.0.0+incompatible", "example.com/repo/api/v2", "+incompatible is not allowed"},
+		{"v12.0.0", "example.com/repo/v12", ""},
+		{"v1.0.0", "example.com/repo/v1", "invalid major version suffix /v1"},
+		{"v0.1.0", "example.com/repo/v0", "invalid major version suffix /v0"},
+		{"v2.0.0", "example.com/repo/v02", "invalid major version suffix /v02"},
+		{"v1.0.0", "example.com/repo/version", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.tag+" "+tc.module, func(t *testing.T) {
+			err := semver.MustParseTaggedVersion(tc.tag).CheckModulePath(tc.module)
+			if tc.error == "" && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			} else if tc.error != "" && (err == nil || !strings.Contains(err.Error(), tc.error)) {
+				t.Errorf("Expected error containing %q, actual %v", tc.error, err)
+			}
+		})
+	}
+}
+
+// Test for ModuleTagPrefix
+func TestModuleTagPrefix(t *testing.T) {
+	testCases := []struct {
+		module   string
+		expected string
+		error    bool
+	}{
+		{"example.com/repo", "", false},
+		{"example.com/repo/v2", "", false},
+		{"example.com/repo/services/api", "services/api", false},
+		{"example.com/repo/services/api/v3", "services/api", false},
+		{"example.com/repository/api", "", true},
+		{"example.com/other", "", true},
+		{"example.com/repo/api/v1", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.module, func(t *testing.T) {
+			prefix, err := semver.ModuleTagPrefix("example.com/repo", tc.module)
+			if tc.error {
+				if err == nil {
+					t.Errorf("Expected an error, actual prefix %q", prefix)
+				}
+				return
+			}
+			if err != nil || prefix != tc.expected {
+				t.Errorf("Expected %q, actual %q (%v)", tc.expected, prefix, err)
+			}
+		})
+	}
+}
+
+// Test for GroupByPrefix and the TagStream lookups
+func TestGroupByPrefix(t *testing.T) {
+	var tags []semver.TaggedVersion
+	for _, s := range []string{"api/v1.2.0", "cli/v0.2.0-beta.1", "api/v2.0.0-rc.1", "api/v1.10.0", "v1.0.0", "api/v2.0.0-rc.2"} {
+		tags = append(tags, semver.MustParseTaggedVersion(s))
+	}
+	streams := semver.GroupByPrefix(tags)
+	if len(streams) != 3 {
+		t.Fatalf("Expected 3 streams, actual %v", streams)
+	}
+
+	testCases := []struct {
+		prefix        string
+		latest        string
+		latestRelease string // "" for none
+		nextMinor     string
+	}{
+		{"", "v1.0.0", "v1.0.0", "v1.1.0"},
+		{"api", "api/v2.0.0-rc.2", "api/v1.10.0", "api/v2.0.0"},
+		{"cli", "cli/v0.2.0-beta.1", "", "cli/v0.2.0"},
+		{"missing", "", "", "missing/v0.1.0"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.prefix, func(t *testing.T) {
+			s, ok := streams[tc.prefix]
+			if !ok {
+				s = semver.TagStream{Prefix: tc.prefix}
+			}
+			if latest, ok := s.Latest(); ok != (tc.latest != "") || (ok && latest.String() != tc.latest) {
+				t.Errorf("Latest: expected %q, actual %v (%v)", tc.latest, latest, ok)
+			}
+			if release, ok := s.LatestRelease(); ok != (tc.latestRelease != "") || (ok && release.String() != tc.latestRelease) {
+				t.Errorf("LatestRelease: expected %q, actual %v (%v)", tc.latestRelease, release, ok)
+			}
+			if next, err := s.Next(semver.BumpMinor, semver.BumpOptions{}); err != nil || next.String() != tc.nextMinor {
+				t.Errorf("Next(minor): expected %s, actual %s (%v)", tc.nextMinor, next, err)
+			}
+		})
+	}
+
+	// the v1 and v2 modules share the prefix "api"
+	v1 := streams["api"].ForModule("example.com/repo/api")
+	if latest, _ := v1.Latest(); len(v1.Versions) != 2 || latest.String() != "api/v1.10.0" {
+		t.Errorf("ForModule(api): expected api/v1.2.0 and api/v1.10.0, actual %v", v1.Versions)
+	}
+	v2 := streams["api"].ForModule("example.com/repo/api/v2")
+	if next, err := v2.Next(semver.BumpPreRelease, semver.BumpOptions{}); err != nil || next.String() != "api/v2.0.0-rc.3" {
+		t.Errorf("ForModule(api/v2).Next(prerelease): expected api/v2.0.0-rc.3, actual %s (%v)", next, err)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FieldError describes a specification violation in one field of a Version.
+type FieldError struct {
+	Component Component // the field that is invalid
+	Value     string    // the value of the field, formatted as a string
+	Offset    int       // byte offset into Value of the problem, or -1 if it applies to the whole value
+	Msg       string    // description of the problem
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	if e.Offset < 0 {
+		return fmt.Sprintf("%s %q: %s", e.Component, e.Value, e.Msg)
+	}
+	return fmt.Sprintf("%s %q at offset %d: %s", e.Component, e.Value, e.Offset, e.Msg)
+}
+
+// ValidationError is returned by Validate when a Version breaks the specification.
+// It wraps one *FieldError per invalid field, so errors.As can reach each of them.
+type ValidationError struct {
+	Errors []error // one *FieldError per invalid field, in field order
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return "semver: invalid version: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the wrapped field errors.
+func (e *ValidationError) Unwrap() []error {
+	return e.Errors
+}
+
+// Validate checks the version against https://semver.org/ and returns
+// a *ValidationError listing every field that violates it, or nil if
+// the version is valid. A valid version is guaranteed to produce a
+// String() that Parse accepts.
+//
+// Rules checked:
+//   - Major, Minor and Patch must be non-negative
+//   - PreRelease and Build identifiers must be non-empty and contain only [0-9A-Za-z-]
+//   - numeric PreRelease identifiers must not have leading zeros
+func (v Version) Validate() error {
+	var errs []error
+	for _, n := range []struct {
+		c     Component
+		value int
+	}{{ComponentMajor, v.Major}, {ComponentMinor, v.Minor}, {ComponentPatch, v.Patch}} {
+		if n.value < 0 {
+			errs = append(errs, &FieldError{Component: n.c, Value: fmt.Sprint(n.value), Offset: -1, Msg: "negative value"})
+		}
+	}
+	errs = validateTail(errs, v.PreRelease, v.Build)
+	if errs != nil {
+		return &ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// validateTail appends a *FieldError to errs for an invalid pre-release or build.
+func validateTail(errs []error, pre, build string) []error {
+	if pre != "" {
+		if offset, msg := checkIdentifiers(pre, true); offset != -1 {
+			errs = append(errs, &FieldError{Component: ComponentPreRelease, Value: pre, Offset: offset, Msg: msg})
+		}
+	}
+	if build != "" {
+		if offset, msg := checkIdentifiers(build, false); offset != -1 {
+			errs = append(errs, &FieldError{Component: ComponentBuild, Value: build, Offset: offset, Msg: msg})
+		}
+	}
+	return errs
+}
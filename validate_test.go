@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Michael D Henderson. All rights reserved.
+
+package semver_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/maloquacious/semver"
+)
+
+// Test for Validate method
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		version    semver.Version
+		components []semver.Component
+	}{
+		{
+			desc:    "valid version",
+			version: semver.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "rc.1", Build: "001.sha-5114f85"},
+		},
+		{
+			desc:       "negative major",
+			version:    semver.Version{Major: -1, Minor: 0, Patch: 0},
+			components: []semver.Component{semver.ComponentMajor},
+		},
+		{
+			desc:       "empty pre-release identifier",
+			version:    semver.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "alpha..1"},
+			components: []semver.Component{semver.ComponentPreRelease},
+		},
+		{
+			desc:       "leading zero in numeric pre-release identifier",
+			version:    semver.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "rc.01"},
+			components: []semver.Component{semver.ComponentPreRelease},
+		},
+		{
+			desc:       "non-ascii build",
+			version:    semver.Version{Major: 1, Minor: 0, Patch: 0, Build: "ünïcode"},
+			components: []semver.Component{semver.ComponentBuild},
+		},
+		{
+			desc:       "every field invalid",
+			version:    semver.Version{Major: -1, Minor: -2, Patch: -3, PreRelease: "a+b", Build: "c."},
+			components: []semver.Component{semver.ComponentMajor, semver.ComponentMinor, semver.ComponentPatch, semver.ComponentPreRelease, semver.ComponentBuild},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.version.Validate()
+			if tc.components == nil {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if _, err := semver.Parse(tc.version.String()); err != nil {
+					t.Errorf("Valid version did not parse: %v", err)
+				}
+				return
+			}
+			var verr *semver.ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("Expected *ValidationError, got %v", err)
+			}
+			var components []semver.Component
+			for _, e := range verr.Errors {
+				var ferr *semver.FieldError
+				if !errors.As(e, &ferr) {
+					t.Fatalf("Expected *FieldError, got %v", e)
+				}
+				components = append(components, ferr.Component)
+			}
+			if !reflect.DeepEqual(components, tc.components) {
+				t.Errorf("Unexpected components. expected: %v, actual: %v", tc.components, components)
+			}
+		})
+	}
+}